@@ -0,0 +1,76 @@
+// Command bootstrap creates ADMIN and SECURITY accounts, which cannot self-register.
+// If the email is already registered, the existing account is given the role instead.
+//
+//	go run ./cmd/bootstrap -email 198601012010041001@uii.ac.id -identity 198601012010041001 \
+//	    -name "Admin Direktorat" -phone 081311122233 -password secret123 -role ADMIN
+package main
+
+import (
+	"campus-lost-and-found/config"
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/repository"
+	"campus-lost-and-found/internal/services"
+	"flag"
+	"log"
+)
+
+func main() {
+	name := flag.String("name", "", "Full name (new accounts only)")
+	email := flag.String("email", "", "Email, must start with the identity number")
+	password := flag.String("password", "", "Password, at least 6 characters (new accounts only)")
+	phone := flag.String("phone", "", "Phone number starting with 08 or +62 (new accounts only)")
+	identity := flag.String("identity", "", "NIP / identity number (new accounts only)")
+	role := flag.String("role", string(models.RoleAdmin), "ADMIN or SECURITY")
+	reason := flag.String("reason", "Created with the bootstrap CLI", "Reason kept in the role audit trail")
+	flag.Parse()
+
+	if *email == "" {
+		log.Fatal("-email is required")
+	}
+	if *role != string(models.RoleAdmin) && *role != string(models.RoleSecurity) {
+		log.Fatal("-role must be ADMIN or SECURITY")
+	}
+
+	config.InitConfig()
+	db := config.GetDB()
+	if err := db.AutoMigrate(&models.User{}, &models.RoleChange{}); err != nil {
+		log.Fatal("Migration failed:", err)
+	}
+
+	userRepo := repository.NewUserRepository(db)
+	userService := services.NewUserService(userRepo)
+
+	if existing, err := userRepo.FindByEmail(*email); err == nil {
+		user, err := userService.ChangeRole(existing.ID.String(), dto.ChangeRoleRequest{
+			Role:   *role,
+			Reason: *reason,
+		}, nil, models.RoleChangeSourceCLI)
+		if err != nil {
+			log.Fatal("Failed to change role:", err)
+		}
+		log.Printf("%s is now %s", user.Email, user.Role)
+		return
+	}
+
+	if *name == "" || *password == "" || *phone == "" || *identity == "" {
+		log.Fatal("-name, -password, -phone and -identity are required for a new account")
+	}
+	if len(*password) < 6 {
+		log.Fatal("-password must be at least 6 characters")
+	}
+
+	user, err := userService.CreateUser(dto.CreateUserRequest{
+		Name:           *name,
+		Email:          *email,
+		Password:       *password,
+		Phone:          *phone,
+		IdentityNumber: *identity,
+		Role:           *role,
+		Reason:         *reason,
+	}, nil, models.RoleChangeSourceCLI)
+	if err != nil {
+		log.Fatal("Failed to create user:", err)
+	}
+	log.Printf("Created %s %s (%s)", user.Role, user.Email, user.ID)
+}
@@ -3,11 +3,14 @@ package main
 import (
 	"campus-lost-and-found/config"
 	"campus-lost-and-found/internal/controllers"
+	"campus-lost-and-found/internal/jobs"
 	"campus-lost-and-found/internal/matching"
 	"campus-lost-and-found/internal/models"
 	"campus-lost-and-found/internal/repository"
 	"campus-lost-and-found/internal/router"
 	"campus-lost-and-found/internal/services"
+	"campus-lost-and-found/internal/utils"
+	"context"
 	"log"
 	"os"
 
@@ -37,20 +40,40 @@ import (
 func main() {
 	// 1. Init Config & DB
 	config.InitConfig()
+	if err := utils.CheckEncryptionKey(); err != nil {
+		log.Fatal("Encryption key: ", err)
+	}
 	db := config.GetDB()
 
 	// 2. Auto Migrate
+	if err := repository.NewAssetRepository(db).BackfillQRVersion(); err != nil {
+		log.Fatal("QR version backfill failed:", err)
+	}
 	err := db.AutoMigrate(
 		&models.User{},
+		&models.RoleChange{},
 		&models.ItemCategory{},
 		&models.CampusLocation{},
 		&models.Asset{},
+		&models.AssetLostEpisode{},
 		&models.FoundEvent{},
+		&models.ScanChallengeUse{},
+		&models.AssetTransfer{},
 		&models.Item{},
+		&models.ItemStatusHistory{},
+		&models.Disposal{},
 		&models.ItemVerification{},
 		&models.ItemContact{},
 		&models.Claim{},
+		&models.ClaimAnswer{},
+		&models.ClaimMessage{},
+		&models.PickupCode{},
+		&models.Custody{},
+		&models.CustodyEvent{},
 		&models.Notification{},
+		&models.Match{},
+		&models.Job{},
+		&models.ScheduledTask{},
 	)
 	if err != nil {
 		log.Fatal("Migration failed:", err)
@@ -63,17 +86,79 @@ func main() {
 	claimRepo := repository.NewClaimRepository(db)
 	notifRepo := repository.NewNotificationRepository(db)
 	enumRepo := repository.NewEnumerationRepository(db)
+	matchRepo := repository.NewMatchRepository(db)
+	jobRepo := repository.NewJobRepository(db)
+	custodyRepo := repository.NewCustodyRepository(db)
+	pickupRepo := repository.NewPickupRepository(db)
+
+	// Full-Text Search Columns & Indexes
+	if err := itemRepo.MigrateSearch(); err != nil {
+		log.Fatal("Search migration failed:", err)
+	}
+
+	// One Match Per Lost/Found Pair
+	if err := matchRepo.MigrateUniqueness(); err != nil {
+		log.Fatal("Match migration failed:", err)
+	}
+
+	// Encrypt verification and claim answers stored before encryption at rest
+	if n, err := itemRepo.EncryptVerificationAnswers(services.SealVerificationAnswer); err != nil {
+		log.Fatal("Verification answer migration failed:", err)
+	} else if n > 0 {
+		log.Printf("Encrypted %d verification answers", n)
+	}
+	if n, err := claimRepo.EncryptAnswers(utils.Encrypt); err != nil {
+		log.Fatal("Claim answer migration failed:", err)
+	} else if n > 0 {
+		log.Printf("Encrypted %d claim answers", n)
+	}
 
 	// Seed Data
 	enumRepo.Seed()
 
+	// Background Jobs
+	jobConfig := jobs.DefaultConfig()
+	jobConfig.Workers = config.AppConfig.JobWorkers
+	jobConfig.MaxAttempts = config.AppConfig.JobMaxAttempts
+	jobRunner := jobs.NewRunner(jobRepo, jobConfig)
+
 	// 4. Init Services
 	notifService := services.NewNotificationService(notifRepo)
 	authService := services.NewAuthService(userRepo)
 	uploadService := services.NewUploadService()
-	assetService := services.NewAssetService(assetRepo, uploadService, notifService)
-	matchingEngine := matching.NewMatchingEngine(notifService)
-	itemService := services.NewItemService(itemRepo, assetRepo, claimRepo, enumRepo, matchingEngine, notifService)
+	assetService := services.NewAssetService(assetRepo, enumRepo, userRepo, uploadService, notifService, jobRunner)
+	matchingEngine := matching.NewMatchingEngine(services.NewQueuedNotifier(jobRunner), matchRepo, matching.Weights{
+		Category: config.AppConfig.MatchWeightCategory,
+		Location: config.AppConfig.MatchWeightLocation,
+		Time:     config.AppConfig.MatchWeightTime,
+		Text:     config.AppConfig.MatchWeightText,
+	}, config.AppConfig.MatchThreshold)
+	pickupService := services.NewPickupService(pickupRepo, itemRepo, claimRepo, assetRepo, matchRepo, custodyRepo, notifService)
+	itemService := services.NewItemService(itemRepo, assetRepo, claimRepo, enumRepo, matchingEngine, notifService, jobRunner, pickupService)
+	matchService := services.NewMatchService(matchRepo, itemService)
+	claimMessageService := services.NewClaimMessageService(claimRepo, uploadService, notifService)
+	claimService := services.NewClaimService(claimRepo, notifService, itemService)
+	custodyService := services.NewCustodyService(custodyRepo, itemRepo, claimRepo, enumRepo, notifService, pickupService)
+	retentionService := services.NewRetentionService(itemRepo, claimRepo, custodyRepo, notifService)
+	publicService := services.NewPublicService(itemRepo, assetRepo)
+	scanService := services.NewScanService(assetRepo, enumRepo, assetService)
+
+	// Job Handlers
+	jobRunner.Register(jobs.TypeMatchItem, itemService.HandleMatchItem)
+	jobRunner.Register(jobs.TypeSendNotification, notifService.HandleSendNotification)
+	jobRunner.Register(jobs.TypeGenerateQR, assetService.HandleGenerateQR)
+	jobRunner.Register(jobs.TypeRematchSweep, itemService.HandleRematchSweep)
+	jobRunner.Every(jobs.TypeRematchSweep, config.AppConfig.MatchSweepInterval)
+	jobRunner.Register(jobs.TypeRetentionSweep, retentionService.HandleRetentionSweep)
+	jobRunner.Every(jobs.TypeRetentionSweep, config.AppConfig.RetentionSweepInterval)
+	jobRunner.Start(context.Background())
+
+	// Re-render QR codes printed before scan links were signed
+	if n, err := assetService.SignLegacyQRCodes(); err != nil {
+		log.Fatal("QR code migration failed:", err)
+	} else if n > 0 {
+		log.Printf("Queued %d unsigned QR codes for re-rendering", n)
+	}
 
 	// 5. Init Controllers
 	authController := controllers.NewAuthController(authService)
@@ -83,6 +168,15 @@ func main() {
 	enumController := controllers.NewEnumerationController(enumRepo)
 	notifController := controllers.NewNotificationController(notifService)
 	uploadController := controllers.NewUploadController(uploadService)
+	matchController := controllers.NewMatchController(matchService)
+	jobController := controllers.NewJobController(jobRunner)
+	custodyController := controllers.NewCustodyController(custodyService)
+	pickupController := controllers.NewPickupController(pickupService)
+	claimMessageController := controllers.NewClaimMessageController(claimMessageService)
+	claimController := controllers.NewClaimController(claimService)
+	disposalController := controllers.NewDisposalController(retentionService)
+	publicController := controllers.NewPublicController(publicService)
+	scanController := controllers.NewScanController(scanService)
 
 	// 6. Init Router
 	appRouter := router.NewAppRouter(
@@ -93,6 +187,15 @@ func main() {
 		enumController,
 		notifController,
 		uploadController,
+		matchController,
+		jobController,
+		custodyController,
+		pickupController,
+		claimMessageController,
+		claimController,
+		disposalController,
+		publicController,
+		scanController,
 	)
 
 	r := gin.Default()
@@ -114,7 +217,7 @@ func main() {
 				c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
 				c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 				c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-				c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT")
+				c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
 			}
 		}
 
@@ -16,8 +16,45 @@ type Config struct {
 	DB             *gorm.DB
 	JWTExpiry      time.Duration
 	AllowedOrigins []string
+	TrustedProxies []string
 	MaxUploadSize  int64
 	UploadPath     string
+	ScanBaseURL    string
+
+	// Matching Engine
+	MatchThreshold      float64
+	MatchWeightCategory float64
+	MatchWeightLocation float64
+	MatchWeightTime     float64
+	MatchWeightText     float64
+
+	// Background Jobs
+	JobWorkers         int
+	JobMaxAttempts     int
+	MatchSweepInterval time.Duration
+
+	// Handover
+	PickupCodeTTL         time.Duration
+	PickupCodeMaxAttempts int
+
+	// Retention
+	RetentionDefaultDays   int
+	RetentionReminderDays  int
+	RetentionSweepInterval time.Duration
+
+	// Public Feed
+	PublicRateLimit int
+	PublicRateBurst int
+
+	// Claims
+	ClaimRateLimit int
+	ClaimRateBurst int
+
+	// Found Reports
+	FoundReportIPRateLimit    int
+	FoundReportIPRateBurst    int
+	FoundReportAssetRateLimit int
+	FoundReportAssetRateBurst int
 }
 
 var AppConfig *Config
@@ -67,6 +104,14 @@ func InitConfig() {
 		allowedOrigins = []string{"*"}
 	}
 
+	// Trusted Proxies (X-Forwarded-For is only honoured from these, none by default)
+	var trustedProxies []string
+	if trustedProxiesStr := os.Getenv("TRUSTED_PROXIES"); trustedProxiesStr != "" {
+		for _, proxy := range strings.Split(trustedProxiesStr, ",") {
+			trustedProxies = append(trustedProxies, strings.TrimSpace(proxy))
+		}
+	}
+
 	// Max Upload Size
 	maxUploadSizeStr := os.Getenv("MAX_UPLOAD_SIZE")
 	var maxUploadSize int64
@@ -82,13 +127,91 @@ func InitConfig() {
 		uploadPath = "./uploads"
 	}
 
+	// Scan Base URL (printed in asset QR codes, the asset ID and token are appended)
+	scanBaseURL := strings.TrimRight(os.Getenv("SCAN_BASE_URL"), "/")
+	if scanBaseURL == "" {
+		scanBaseURL = "https://campuslf.afsar.my.id/scan"
+	}
+
 	AppConfig = &Config{
 		DB:             db,
 		JWTExpiry:      jwtExpiry,
 		AllowedOrigins: allowedOrigins,
+		TrustedProxies: trustedProxies,
 		MaxUploadSize:  maxUploadSize,
 		UploadPath:     uploadPath,
+		ScanBaseURL:    scanBaseURL,
+
+		// Matching Engine (scores are 0-100, weights are relative)
+		MatchThreshold:      getEnvFloat("MATCH_THRESHOLD", 60),
+		MatchWeightCategory: getEnvFloat("MATCH_WEIGHT_CATEGORY", 35),
+		MatchWeightLocation: getEnvFloat("MATCH_WEIGHT_LOCATION", 25),
+		MatchWeightTime:     getEnvFloat("MATCH_WEIGHT_TIME", 20),
+		MatchWeightText:     getEnvFloat("MATCH_WEIGHT_TEXT", 20),
+
+		// Background Jobs
+		JobWorkers:         getEnvInt("JOB_WORKERS", 4),
+		JobMaxAttempts:     getEnvInt("JOB_MAX_ATTEMPTS", 5),
+		MatchSweepInterval: getEnvDuration("MATCH_SWEEP_INTERVAL", time.Hour),
+
+		// Handover
+		PickupCodeTTL:         getEnvDuration("PICKUP_CODE_TTL", 72*time.Hour),
+		PickupCodeMaxAttempts: getEnvInt("PICKUP_CODE_MAX_ATTEMPTS", 5),
+
+		// Retention (days an unclaimed found item is kept when its category has no rule)
+		RetentionDefaultDays:   getEnvInt("ITEM_RETENTION_DAYS", 60),
+		RetentionReminderDays:  getEnvInt("RETENTION_REMINDER_DAYS", 7),
+		RetentionSweepInterval: getEnvDuration("RETENTION_SWEEP_INTERVAL", 6*time.Hour),
+
+		// Public Feed (requests per minute per IP)
+		PublicRateLimit: getEnvInt("PUBLIC_RATE_LIMIT", 60),
+		PublicRateBurst: getEnvInt("PUBLIC_RATE_BURST", 20),
+
+		// Claims (submissions per minute per user)
+		ClaimRateLimit: getEnvInt("CLAIM_RATE_LIMIT", 2),
+		ClaimRateBurst: getEnvInt("CLAIM_RATE_BURST", 5),
+
+		// Found Reports (requests per minute, per IP and per asset)
+		FoundReportIPRateLimit:    getEnvInt("FOUND_REPORT_IP_RATE_LIMIT", 10),
+		FoundReportIPRateBurst:    getEnvInt("FOUND_REPORT_IP_RATE_BURST", 10),
+		FoundReportAssetRateLimit: getEnvInt("FOUND_REPORT_ASSET_RATE_LIMIT", 2),
+		FoundReportAssetRateBurst: getEnvInt("FOUND_REPORT_ASSET_RATE_BURST", 5),
+	}
+}
+
+// getEnvDuration reads a duration (e.g. 30m, 1h) from the environment, falling back to def when unset or invalid
+func getEnvDuration(key string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+// getEnvInt reads a positive int from the environment, falling back to def when unset or invalid
+func getEnvInt(key string, def int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+	var i int
+	if _, err := fmt.Sscanf(value, "%d", &i); err != nil || i <= 0 {
+		return def
+	}
+	return i
+}
+
+// getEnvFloat reads a float from the environment, falling back to def when unset or invalid
+func getEnvFloat(key string, def float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+	var f float64
+	if _, err := fmt.Sscanf(value, "%g", &f); err != nil {
+		return def
 	}
+	return f
 }
 
 func GetDB() *gorm.DB {
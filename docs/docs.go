@@ -24,14 +24,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/assets": {
-            "post": {
+        "/admin/jobs/failed": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create asset and generate QR code",
+                "description": "Get jobs that exhausted their retries (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -39,29 +39,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
-                ],
-                "summary": "Create a new asset",
-                "parameters": [
-                    {
-                        "description": "Create Asset Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateAssetRequest"
-                        }
-                    }
+                    "admin"
                 ],
+                "summary": "Get failed background jobs",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.AssetResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Job"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -72,9 +64,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assets/lost": {
-            "get": {
-                "description": "Get a list of assets reported as lost",
+        "/admin/jobs/{id}/requeue": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reset a dead job's attempts and queue it again (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -82,21 +79,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "admin"
+                ],
+                "summary": "Re-queue a failed job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all lost assets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.AssetResponse"
+                            "$ref": "#/definitions/models.Job"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -107,14 +119,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assets/my": {
-            "get": {
+        "/admin/users": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all assets belonging to the authenticated user",
+                "description": "Create an account with any role, including ADMIN and SECURITY, recorded in the role audit trail (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -122,21 +134,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "admin"
+                ],
+                "summary": "Create a user with any role",
+                "parameters": [
+                    {
+                        "description": "Create User Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserRequest"
+                        }
+                    }
                 ],
-                "summary": "Get user's assets",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.AssetResponse"
+                            "$ref": "#/definitions/dto.UserDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -147,14 +176,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assets/{id}": {
-            "get": {
+        "/admin/users/{id}/role": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get asset by ID",
+                "description": "Promote or demote a user, recorded in the role audit trail (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -162,23 +191,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "admin"
                 ],
-                "summary": "Get asset details",
+                "summary": "Change a user's role",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Asset ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Change Role Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangeRoleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.AssetResponse"
+                            "$ref": "#/definitions/dto.UserDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
@@ -193,14 +249,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assets/{id}/found-events": {
+        "/admin/users/{id}/role-changes": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get history of found events",
+                "description": "Get every role given to a user by an admin or the bootstrap CLI, newest first (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -208,13 +264,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "admin"
                 ],
-                "summary": "Get found events for an asset",
+                "summary": "Get a user's role audit trail",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Asset ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -226,7 +282,16 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.FoundEvent"
+                                "$ref": "#/definitions/models.RoleChange"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
@@ -242,14 +307,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assets/{id}/lost-mode": {
-            "put": {
+        "/assets": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Enable or disable lost mode",
+                "description": "Create asset and generate QR code",
                 "consumes": [
                     "application/json"
                 ],
@@ -259,22 +324,15 @@ const docTemplate = `{
                 "tags": [
                     "assets"
                 ],
-                "summary": "Update lost mode",
+                "summary": "Create a new asset",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Asset ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update Lost Mode Request",
+                        "description": "Create Asset Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateLostModeRequest"
+                            "$ref": "#/definitions/dto.CreateAssetRequest"
                         }
                     }
                 ],
@@ -282,10 +340,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dto.AssetResponse"
                         }
                     },
                     "400": {
@@ -300,40 +355,74 @@ const docTemplate = `{
                 }
             }
         },
-        "/assets/{id}/report-found": {
-            "post": {
-                "description": "Report that an asset has been found by scanning QR",
-                "consumes": [
-                    "application/json"
+        "/assets/labels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Render the QR codes of your assets on print-ready sticker sheets, each label with the category and an \"If found, scan me\" message. One label per ID, in order; repeat an ID for several stickers. PDFs span as many sheets as needed, an SVG holds one sheet. Print at 100% scale",
                 "produces": [
-                    "application/json"
+                    "application/pdf",
+                    "image/svg+xml"
                 ],
                 "tags": [
                     "assets"
                 ],
-                "summary": "Report asset found (Scan QR)",
+                "summary": "Print QR sticker sheets",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Asset ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Comma-separated asset IDs (at most 200)",
+                        "name": "ids",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Report Found Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReportFoundRequest"
-                        }
+                        "enum": [
+                            "a4-3x8",
+                            "a4-2x7",
+                            "a4-4x10",
+                            "letter-3x10"
+                        ],
+                        "type": "string",
+                        "description": "Sticker paper (default a4-3x8)",
+                        "name": "layout",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pdf",
+                            "svg"
+                        ],
+                        "type": "string",
+                        "description": "Output format (default pdf)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "L",
+                            "M",
+                            "Q",
+                            "H"
+                        ],
+                        "type": "string",
+                        "description": "QR error correction level (default H)",
+                        "name": "ecc",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -341,8 +430,17 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -353,9 +451,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login": {
-            "post": {
-                "description": "Login with email and password",
+        "/assets/lost": {
+            "get": {
+                "description": "Get a list of assets reported as lost",
                 "consumes": [
                     "application/json"
                 ],
@@ -363,29 +461,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "assets"
                 ],
-                "summary": "Login user",
+                "summary": "Get all lost assets",
                 "parameters": [
                     {
-                        "description": "Login Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
-                        }
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.AuthResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Page"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.AssetResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -396,39 +527,77 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/refresh": {
-            "post": {
-                "description": "Get new access token using refresh token",
-                "consumes": [
+        "/assets/my": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all assets belonging to the authenticated user",
+                "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "assets"
                 ],
-                "summary": "Refresh access token",
+                "summary": "Get user's assets",
                 "parameters": [
                     {
-                        "description": "Refresh Token Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.RefreshTokenRequest"
-                        }
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.AuthResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Page"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.AssetResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -439,9 +608,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/register": {
-            "post": {
-                "description": "Register a new user with email and password",
+        "/assets/transfers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the asset transfers you offered (OUTGOING) or received (INCOMING), newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -449,29 +623,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
-                ],
-                "summary": "Register a new user",
-                "parameters": [
-                    {
-                        "description": "Register Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.RegisterRequest"
-                        }
-                    }
+                    "assets"
                 ],
+                "summary": "Get my asset transfers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.AuthResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.AssetTransferResponse"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -482,14 +648,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/claims/{id}/decide": {
-            "put": {
+        "/assets/transfers/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Decide on a claim (Finder only)",
+                "description": "Withdraw a transfer the recipient has not answered yet (sender only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -497,30 +663,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "claims"
+                    "assets"
                 ],
-                "summary": "Approve or Reject a claim",
+                "summary": "Cancel an asset transfer",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Claim ID",
+                        "description": "Transfer ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Decide Claim Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.DecideClaimRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AssetTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -536,42 +699,27 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/enumerations/campus-locations": {
-            "get": {
-                "description": "Get list of campus locations",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "enumerations"
-                ],
-                "summary": "Get campus locations",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.CampusLocation"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/assets/transfers/{id}/accept": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new campus location",
+                "description": "Become the owner of an asset offered to you. Its found reports and lost history move with it (recipient only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -579,25 +727,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "enumerations"
+                    "assets"
                 ],
-                "summary": "Create campus location",
+                "summary": "Accept an asset transfer",
                 "parameters": [
                     {
-                        "description": "Create Location Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.CreateLocationRequest"
-                        }
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.CampusLocation"
+                            "$ref": "#/definitions/dto.AssetTransferResponse"
                         }
                     },
                     "400": {
@@ -608,42 +754,36 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/enumerations/item-categories": {
-            "get": {
-                "description": "Get list of item categories",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "enumerations"
-                ],
-                "summary": "Get item categories",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.ItemCategory"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/assets/transfers/{id}/decline": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new item category",
+                "description": "Turn down an asset offered to you; the sender stays the owner (recipient only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -651,25 +791,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "enumerations"
+                    "assets"
                 ],
-                "summary": "Create item category",
+                "summary": "Decline an asset transfer",
                 "parameters": [
                     {
-                        "description": "Create Category Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controllers.CreateCategoryRequest"
-                        }
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.ItemCategory"
+                            "$ref": "#/definitions/dto.AssetTransferResponse"
                         }
                     },
                     "400": {
@@ -680,18 +818,36 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/items": {
+        "/assets/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a list of all items (Lost \u0026 Found) with optional filters",
+                "description": "Get asset by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -699,35 +855,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "assets"
                 ],
-                "summary": "Get all items",
+                "summary": "Get asset details",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by status (e.g., OPEN, CLAIMED)",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by type (LOST, FOUND)",
-                        "name": "type",
-                        "in": "query"
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.ItemResponse"
-                            }
+                            "$ref": "#/definitions/dto.AssetResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -736,16 +884,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/items/found": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Report an item found without QR code",
+                "description": "Change the category, description or private image of an asset. Empty fields are left unchanged (owner only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -753,17 +899,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "assets"
                 ],
-                "summary": "Report a found item (Finder First)",
+                "summary": "Edit an asset",
                 "parameters": [
                     {
-                        "description": "Create Found Item Request",
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Asset Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateFoundItemRequest"
+                            "$ref": "#/definitions/dto.UpdateAssetRequest"
                         }
                     }
                 ],
@@ -771,7 +924,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ItemResponse"
+                            "$ref": "#/definitions/dto.AssetResponse"
                         }
                     },
                     "400": {
@@ -782,18 +935,34 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
-            }
-        },
-        "/items/lost": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Report a lost item without QR code",
+                "description": "Delete an asset and revoke its QR code, so printed stickers stop working. Lost mode, a pending transfer and open match suggestions are closed; found reports are kept (owner only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -801,29 +970,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "assets"
                 ],
-                "summary": "Report a lost item (Ad-Hoc)",
+                "summary": "Delete an asset",
                 "parameters": [
                     {
-                        "description": "Create Lost Item Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateLostItemRequest"
-                        }
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ItemResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -834,14 +1013,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/{id}": {
+        "/assets/{id}/found-events": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed information about a specific item",
+                "description": "Get the found reports on an asset, newest first, with spam flags and guest contacts (owner only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -849,13 +1028,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "assets"
                 ],
-                "summary": "Get item by ID",
+                "summary": "Get found events for an asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -865,7 +1044,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ItemResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.FoundEvent"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
@@ -878,14 +1069,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/assets/{id}/found-events/{event_id}/reply": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an item (Finder or Owner only)",
+                "description": "Relay a message between the owner and a signed-in finder as a notification, without sharing contact details. Guest reports are answered through the contact they left",
                 "consumes": [
                     "application/json"
                 ],
@@ -893,16 +1086,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "assets"
                 ],
-                "summary": "Delete an item",
+                "summary": "Reply on a found report",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Found Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reply Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.FoundEventReplyRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -915,6 +1124,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "403": {
                         "description": "Forbidden",
                         "schema": {
@@ -936,14 +1154,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/{id}/claim": {
-            "post": {
+        "/assets/{id}/found-events/{event_id}/spam": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Claim a found item",
+                "description": "Flag a found report as spam. It stays in the history, and its reporter (the account, or the address of a guest) can no longer report this asset (owner only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -951,32 +1169,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "assets"
                 ],
-                "summary": "Submit a claim for an item",
+                "summary": "Mark a found report as spam",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Create Claim Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateClaimRequest"
-                        }
+                        "type": "string",
+                        "description": "Found Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ClaimResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
@@ -987,18 +1206,36 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/items/{id}/claims": {
+        "/assets/{id}/lost-history": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all claims for an item (Finder only)",
+                "description": "Get every lost-mode episode of an asset with its last-seen context (owner only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1006,13 +1243,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "assets"
                 ],
-                "summary": "Get claims for an item",
+                "summary": "Get lost-mode history for an asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1024,7 +1261,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Claim"
+                                "$ref": "#/definitions/models.AssetLostEpisode"
                             }
                         }
                     },
@@ -1040,14 +1277,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/notifications": {
-            "get": {
+        "/assets/{id}/lost-mode": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get notifications for the authenticated user",
+                "description": "Enable or disable lost mode, optionally with last-seen location, description and lost-since time. While lost mode is on, fields left out keep their current value",
                 "consumes": [
                     "application/json"
                 ],
@@ -1055,30 +1292,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notifications"
+                    "assets"
+                ],
+                "summary": "Update lost mode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Lost Mode Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateLostModeRequest"
+                        }
+                    }
                 ],
-                "summary": "Get user notifications",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Notification"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/notifications/{id}/read": {
-            "put": {
+        "/assets/{id}/qr/rotate": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Mark a notification as read",
+                "description": "Revoke every printed QR sticker of the asset, e.g. after one was stolen, and render a new code. qr_code_url is empty until the new image is ready (owner only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1086,13 +1368,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notifications"
+                    "assets"
                 ],
-                "summary": "Mark notification as read",
+                "summary": "Rotate an asset's QR code",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Notification ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1107,35 +1389,62 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/upload": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Upload a file to storage",
-                "consumes": [
-                    "multipart/form-data"
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/assets/{id}/report-found": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report that an asset has been found. The finder is recorded on the report and can talk to the owner through it. Guests report through the scan page instead (POST /scan/{id}/report-found). Rate limited per IP and per asset; reporters the owner marked as spam are refused",
+                "consumes": [
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "upload"
+                    "assets"
                 ],
-                "summary": "Upload a file",
+                "summary": "Report asset found (Scan QR)",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "File to upload",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Report Found Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportFoundRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1156,18 +1465,54 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/users": {
-            "get": {
+        "/assets/{id}/transfer": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a list of all users",
+                "description": "Offer an asset to another account by email, e.g. after selling it. Ownership moves once the recipient accepts; found reports, lost history and the QR code stay with the asset. Lost mode must be off (owner only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1175,21 +1520,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "assets"
+                ],
+                "summary": "Transfer an asset to another user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer Asset Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferAssetRequest"
+                        }
+                    }
                 ],
-                "summary": "Get all users",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.UserDetailResponse"
+                            "$ref": "#/definitions/dto.AssetTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1200,14 +1578,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Update authenticated user's name and/or phone",
+        "/auth/login": {
+            "post": {
+                "description": "Login with email and password",
                 "consumes": [
                     "application/json"
                 ],
@@ -1215,17 +1588,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Update user profile",
+                "summary": "Login user",
                 "parameters": [
                     {
-                        "description": "Update User Request",
+                        "description": "Login Request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                            "$ref": "#/definitions/dto.LoginRequest"
                         }
                     }
                 ],
@@ -1233,20 +1606,54 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserDetailResponse"
+                            "$ref": "#/definitions/dto.AuthResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Get new access token using refresh token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "description": "Refresh Token Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AuthResponse"
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1257,14 +1664,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get a specific user by their ID",
+        "/auth/register": {
+            "post": {
+                "description": "Register a new user with email and password",
                 "consumes": [
                     "application/json"
                 ],
@@ -1272,23 +1674,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "auth"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Register a new user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Register Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RegisterRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserDetailResponse"
+                            "$ref": "#/definitions/dto.AuthResponse"
                         }
                     },
                     "400": {
@@ -1299,9 +1703,40 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/claims/escalated": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the queue of escalated claims with the claimant's answers, oldest appeal first (Security, Admin)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Get appealed claims",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Claim"
+                            }
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1311,307 +1746,4239 @@ const docTemplate = `{
                     }
                 }
             }
-        }
+        },
+        "/claims/my": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the authenticated user's claims with their status, rejection reason and appeal outcome",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Get my claims",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Page"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.MyClaimResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/claims/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Withdraw a pending, escalated or approved claim (Claimant only). Withdrawing an approved claim before handover reopens the item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Withdraw a claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/claims/{id}/appeal": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Escalate a rejected claim to a SECURITY or ADMIN reviewer (Claimant only, once per claim)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Appeal a rejected claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Appeal",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AppealClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MyClaimResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/claims/{id}/decide": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Decide on a claim (Finder only). Approving rejects every other pending claim on the item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Approve or Reject a claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decide Claim Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DecideClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/claims/{id}/messages": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the claim thread, oldest first (Finder, Claimant or Security)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Get a claim's messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ClaimMessageResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send a message in the claim thread as JSON, or as multipart/form-data with an optional image \"attachment\". The other side is notified (Finder, Claimant or Security)",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Send a message on a claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Message (JSON)",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClaimMessageRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Message text (multipart)",
+                        "name": "body",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image attachment (multipart)",
+                        "name": "attachment",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClaimMessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/claims/{id}/pickup-code": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the one-time code (numeric and QR) to show the finder or security when collecting the item (Claimant only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Get the pickup code of an approved claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PickupCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace an expired or locked pickup code; the old code stops working (Claimant only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Request a new pickup code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PickupCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/claims/{id}/review": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record the final decision on an escalated claim (Security, Admin). APPROVED approves the claim and issues the pickup code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "claims"
+                ],
+                "summary": "Review an appealed claim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Claim ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MyClaimResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/custody/check-in": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a found item handed to security, with the desk and storage bin it is kept in (Security only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custody"
+                ],
+                "summary": "Check in an item at a security desk",
+                "parameters": [
+                    {
+                        "description": "Check In Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CustodyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/custody/desks/{id}/inventory": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the items a security desk currently holds, ordered by storage bin (Security only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custody"
+                ],
+                "summary": "Get a desk's inventory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Desk (Campus Location) ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.CustodyResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/custody/items/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get where an item is kept and every signed custody event, with signature verification (Security only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custody"
+                ],
+                "summary": "Get an item's custody chain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CustodyResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/custody/items/{id}/check-out": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Hand an item in custody to the approved claimant after checking their NIM/NIP in person (Security only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custody"
+                ],
+                "summary": "Check out an item to its owner",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Check Out Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CheckOutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CustodyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/custody/items/{id}/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move an item in custody to another desk, or to another storage bin at the same desk (Security only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custody"
+                ],
+                "summary": "Transfer an item between desks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transfer Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TransferCustodyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CustodyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/disposals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get expired found items whose retention period ended without a claim, with the desk holding them (SECURITY, ADMIN)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disposals"
+                ],
+                "summary": "Get the disposal queue",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.DisposalQueueResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record that an expired item was donated, disposed of or transferred. The item is archived and leaves security custody (SECURITY, ADMIN)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disposals"
+                ],
+                "summary": "Record an item's disposition",
+                "parameters": [
+                    {
+                        "description": "Disposition",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DisposeItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DisposalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/enumerations/campus-locations": {
+            "get": {
+                "description": "Get list of campus locations",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "enumerations"
+                ],
+                "summary": "Get campus locations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.CampusLocation"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new campus location, optionally marked as a security desk (ADMIN only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "enumerations"
+                ],
+                "summary": "Create campus location",
+                "parameters": [
+                    {
+                        "description": "Create Location Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateLocationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.CampusLocation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/enumerations/item-categories": {
+            "get": {
+                "description": "Get list of item categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "enumerations"
+                ],
+                "summary": "Get item categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.ItemCategory"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new item category (ADMIN only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "enumerations"
+                ],
+                "summary": "Create item category",
+                "parameters": [
+                    {
+                        "description": "Create Category Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.CreateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItemCategory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/enumerations/item-categories/{id}/retention": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set how many days unclaimed found items in a category are kept before they expire (ADMIN only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "enumerations"
+                ],
+                "summary": "Set category retention",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Retention",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controllers.UpdateCategoryRetentionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ItemCategory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a list of all items (Lost \u0026 Found) with optional filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get all items",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by status (OPEN, CLAIMED, RESOLVED, EXPIRED or ARCHIVED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by type (LOST, FOUND)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Page"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ItemResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/found": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report an item found without QR code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Report a found item (Finder First)",
+                "parameters": [
+                    {
+                        "description": "Create Found Item Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateFoundItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/lost": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Report a lost item without QR code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Report a lost item (Ad-Hoc)",
+                "parameters": [
+                    {
+                        "description": "Create Lost Item Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateLostItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/my": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get items reported by the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get my items",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Page"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.ItemResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get detailed information about a specific item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get item by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ItemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update item details (Finder or Owner only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Update an item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Item Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ItemResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an item (Finder or Owner only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Delete an item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/claim": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Claim a found item. One pending claim per user and item; finders cannot claim their own item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Submit a claim for an item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Claim Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClaimResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/claims": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all claims for an item (Finder only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get claims for an item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Claim"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/handover": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Enter or scan the claimant's pickup code. The item is resolved and the claim completed. Items kept at a security desk are checked out of custody (Finder or Security)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Confirm handover with a pickup code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Handover Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.HandoverRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HandoverResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get every status change of an item with who made it and why, oldest first (Finder, Owner or Security)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get item status history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ItemStatusHistoryResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/matches": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get match suggestions for a lost item, lost asset or found item (owner or finder only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Get match suggestions for an item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item or Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (SUGGESTED, ACCEPTED, DISMISSED)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.MatchResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/status": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move an item to another status (Finder, Owner or Security). Only transitions allowed by the item lifecycle are accepted, and each is recorded in the item's history. CLAIMED is only reached by approving a claim",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Update item status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Item Status Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateItemStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/matches/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accept (submits a prefilled claim) or dismiss a match suggestion (lost report owner only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matches"
+                ],
+                "summary": "Accept or dismiss a match",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Match ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Match Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateMatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get notifications for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get user notifications",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Page"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/models.Notification"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark a notification as read",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/public/items": {
+            "get": {
+                "description": "Browse OPEN found and lost items without an account. Personal names, private images and verification details are left out, and contacts are only shown when the reporter enabled show_phone. Rate limited per IP",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "public"
+                ],
+                "summary": "Get the public item feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by type (LOST or FOUND)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at or -created_at (default)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Page"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.PublicItemResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/public/items/{id}": {
+            "get": {
+                "description": "Get the redacted view of an item, or of an asset in lost mode, without an account. Rate limited per IP",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "public"
+                ],
+                "summary": "Get a public item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item or Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublicItemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/public/items/{id}/thumbnail": {
+            "get": {
+                "description": "Get a small blurred JPEG preview of an item's uploaded image. Rate limited per IP",
+                "produces": [
+                    "image/jpeg"
+                ],
+                "tags": [
+                    "public"
+                ],
+                "summary": "Get an item's public preview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/{id}": {
+            "get": {
+                "description": "Landing page of an asset's QR sticker, no account needed. The QR code links here with a signed token t; a missing or forged token is a 404 and a token from a code the owner rotated is a 410. Returns the category, a message for the finder, the security desks (sorted by distance, with the nearest picked out, when lat and lng are given) and a challenge to answer when reporting the asset found. Rate limited per IP",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scan"
+                ],
+                "summary": "Scan an asset QR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Token from the QR code",
+                        "name": "t",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Scanner latitude",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Scanner longitude",
+                        "name": "lng",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/scan/{id}/report-found": {
+            "post": {
+                "description": "Tell the owner where their asset was found, without an account. The challenge token and question come from GET /scan/{id}. Guests may leave a contact_handle; a signed-in finder is recorded on the report instead. Rate limited per IP and per asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scan"
+                ],
+                "summary": "Report a scanned asset found",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Scan Report Found Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScanReportFoundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search (English and Indonesian stemming) over item title, description, location description and lost asset description, ranked by relevance. Only OPEN items and lost assets unless another status is asked for",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Search items and lost assets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (OPEN, CLAIMED, RESOLVED, EXPIRED or ARCHIVED, default OPEN)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by campus location ID",
+                        "name": "location_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only reports on or after this date (YYYY-MM-DD)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only reports on or before this date (YYYY-MM-DD)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by urgency (NORMAL, HIGH, CRITICAL), lost items only",
+                        "name": "urgency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max results (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.SearchResultResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a file to storage",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "upload"
+                ],
+                "summary": "Upload a file",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File to upload",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a list of all users (ADMIN only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get all users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor from next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "created_at, name; prefix with - for descending (default -created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.Page"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dto.UserDetailResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update authenticated user's name and/or phone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update user profile",
+                "parameters": [
+                    {
+                        "description": "Update User Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific user by their ID. Phone and identity number are only returned for your own profile or to admins",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
         "controllers.CreateCategoryRequest": {
             "type": "object",
             "required": [
-                "name"
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "retention_days": {
+                    "description": "Defaults to ITEM_RETENTION_DAYS",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 90
+                }
+            }
+        },
+        "controllers.CreateLocationRequest": {
+            "type": "object",
+            "required": [
+                "latitude",
+                "longitude",
+                "name"
+            ],
+            "properties": {
+                "is_security_desk": {
+                    "description": "Staffed security post, suggested to finders who scan an asset QR",
+                    "type": "boolean"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controllers.UpdateCategoryRetentionRequest": {
+            "type": "object",
+            "required": [
+                "retention_days"
+            ],
+            "properties": {
+                "retention_days": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 30
+                }
+            }
+        },
+        "dto.AppealClaimRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "The scratch on the back matches the photo I attached"
+                }
+            }
+        },
+        "dto.AssetResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_seen_location": {
+                    "type": "string"
+                },
+                "lost_mode": {
+                    "type": "boolean"
+                },
+                "lost_since": {
+                    "description": "Current lost-mode episode, only set while the asset is lost",
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "private_image_url": {
+                    "description": "Only for owner",
+                    "type": "string"
+                },
+                "qr_code_url": {
+                    "description": "Only for owner, the image encodes the signed scan link",
+                    "type": "string"
+                },
+                "scan_url": {
+                    "description": "Only for owner, the link in the QR code",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AssetTransferResponse": {
+            "type": "object",
+            "properties": {
+                "asset_description": {
+                    "type": "string"
+                },
+                "asset_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "direction": {
+                    "description": "INCOMING or OUTGOING, seen from the caller",
+                    "type": "string",
+                    "example": "INCOMING"
+                },
+                "from_user_id": {
+                    "type": "string"
+                },
+                "from_user_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "responded_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "PENDING, ACCEPTED, DECLINED or CANCELLED",
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "to_user_id": {
+                    "type": "string"
+                },
+                "to_user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                }
+            }
+        },
+        "dto.ChangeRoleRequest": {
+            "type": "object",
+            "required": [
+                "reason",
+                "role"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Transferred to the campus security unit"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "PUBLIK",
+                        "MAHASISWA",
+                        "STAFF_DOSEN",
+                        "SECURITY",
+                        "ADMIN"
+                    ],
+                    "example": "SECURITY"
+                }
+            }
+        },
+        "dto.CheckInRequest": {
+            "type": "object",
+            "required": [
+                "desk_id",
+                "item_id",
+                "storage_bin"
+            ],
+            "properties": {
+                "desk_id": {
+                    "description": "Campus location of the security desk",
+                    "type": "string",
+                    "example": "90880496-c0de-4af4-bb06-30e1e0ec9a53"
+                },
+                "item_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Handed over by the finder, screen cracked"
+                },
+                "storage_bin": {
+                    "type": "string",
+                    "example": "Shelf B-2"
+                }
+            }
+        },
+        "dto.CheckOutRequest": {
+            "type": "object",
+            "required": [
+                "identity_number"
+            ],
+            "properties": {
+                "identity_number": {
+                    "description": "Checked against the approved claimant's NIM/NIP",
+                    "type": "string",
+                    "example": "21523120"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "KTM checked at the desk"
+                }
+            }
+        },
+        "dto.ClaimAnswerRequest": {
+            "type": "object",
+            "required": [
+                "answer",
+                "verification_id"
+            ],
+            "properties": {
+                "answer": {
+                    "type": "string",
+                    "example": "Blue"
+                },
+                "verification_id": {
+                    "type": "string",
+                    "example": "3f0c9a56-7d1e-4c1b-9a0e-2b7f5d8c4e11"
+                }
+            }
+        },
+        "dto.ClaimMessageRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string",
+                    "example": "Can you describe the keychain attached to it?"
+                }
+            }
+        },
+        "dto.ClaimMessageResponse": {
+            "type": "object",
+            "properties": {
+                "attachment_url": {
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "claim_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "sender_id": {
+                    "type": "string"
+                },
+                "sender_name": {
+                    "type": "string"
+                },
+                "sender_role": {
+                    "description": "FINDER, CLAIMANT or SECURITY",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ClaimResponse": {
+            "type": "object",
+            "properties": {
+                "answer_input": {
+                    "type": "string"
+                },
+                "claimer": {
+                    "$ref": "#/definitions/dto.ItemUserResponse"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ContactRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "value"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string",
+                    "example": "WHATSAPP"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "08123456789"
+                }
+            }
+        },
+        "dto.ContactResponse": {
+            "type": "object",
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateAssetRequest": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "description"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "lost_mode": {
+                    "type": "boolean"
+                },
+                "private_image_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateClaimRequest": {
+            "type": "object",
+            "properties": {
+                "answer_input": {
+                    "description": "Optional extra description",
+                    "type": "string",
+                    "example": "Blue wallet with university ID"
+                },
+                "answers": {
+                    "description": "One per verification question of the item",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClaimAnswerRequest"
+                    }
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "http://example.com/proof.jpg"
+                }
+            }
+        },
+        "dto.CreateFoundItemRequest": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "date_found",
+                "location_id",
+                "return_method",
+                "title",
+                "verifications"
+            ],
+            "properties": {
+                "auto_approve": {
+                    "description": "Approve claims whose answers all match exactly",
+                    "type": "boolean",
+                    "example": false
+                },
+                "category_id": {
+                    "type": "string",
+                    "example": "1bd43cf7-fc4f-4968-bd4f-c45699b03c18"
+                },
+                "cod": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ContactRequest"
+                    }
+                },
+                "date_found": {
+                    "description": "Format YYYY-MM-DD or RFC3339",
+                    "type": "string",
+                    "example": "2023-10-27"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "http://example.com/image.jpg"
+                },
+                "location_id": {
+                    "type": "string",
+                    "example": "e9464495-bfe5-4ed0-8ea4-a2d69afa0b39"
+                },
+                "return_method": {
+                    "type": "string",
+                    "enum": [
+                        "BRING_BY_FINDER",
+                        "HANDED_TO_SECURITY"
+                    ],
+                    "example": "BRING_BY_FINDER"
+                },
+                "show_phone": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Blue Wallet"
+                },
+                "verifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VerificationRequest"
+                    }
+                }
+            }
+        },
+        "dto.CreateLostItemRequest": {
+            "type": "object",
+            "required": [
+                "category_id",
+                "date_lost",
+                "location_last_seen",
+                "title"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "string",
+                    "example": "1bd43cf7-fc4f-4968-bd4f-c45699b03c18"
+                },
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ContactRequest"
+                    }
+                },
+                "date_lost": {
+                    "description": "Format YYYY-MM-DD",
+                    "type": "string",
+                    "example": "2023-10-26"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Black case with a sticker"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "http://example.com/iphone.jpg"
+                },
+                "location_last_seen": {
+                    "type": "string",
+                    "example": "Canteen"
+                },
+                "offer_reward": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "show_phone": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "title": {
+                    "type": "string",
+                    "example": "iPhone 13"
+                },
+                "urgency": {
+                    "type": "string",
+                    "enum": [
+                        "NORMAL",
+                        "HIGH",
+                        "CRITICAL"
+                    ],
+                    "example": "HIGH"
+                }
+            }
+        },
+        "dto.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "identity_number",
+                "name",
+                "password",
+                "phone",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "198701012015041001@uii.ac.id"
+                },
+                "faculty": {
+                    "type": "string",
+                    "example": "Fakultas Teknologi Industri"
+                },
+                "identity_number": {
+                    "type": "string",
+                    "example": "198701012015041001"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Joko Susilo"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "password123"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "081377788899"
+                },
+                "reason": {
+                    "description": "Kept in the role audit trail",
+                    "type": "string",
+                    "example": "Security officer for the FTI building"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "PUBLIK",
+                        "MAHASISWA",
+                        "STAFF_DOSEN",
+                        "SECURITY",
+                        "ADMIN"
+                    ],
+                    "example": "SECURITY"
+                }
+            }
+        },
+        "dto.CustodyEventResponse": {
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "desk_id": {
+                    "type": "string"
+                },
+                "desk_name": {
+                    "type": "string"
+                },
+                "from_desk_id": {
+                    "type": "string"
+                },
+                "from_desk_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "recipient_id": {
+                    "type": "string"
+                },
+                "recipient_name": {
+                    "type": "string"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "storage_bin": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "valid": {
+                    "description": "Signature matches the event and the chain before it",
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.CustodyResponse": {
+            "type": "object",
+            "properties": {
+                "category_name": {
+                    "type": "string"
+                },
+                "checked_in_at": {
+                    "type": "string"
+                },
+                "checked_out_at": {
+                    "type": "string"
+                },
+                "checked_out_to_id": {
+                    "type": "string"
+                },
+                "desk_id": {
+                    "type": "string"
+                },
+                "desk_name": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CustodyEventResponse"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "item_title": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "IN_CUSTODY or CHECKED_OUT",
+                    "type": "string"
+                },
+                "storage_bin": {
+                    "type": "string"
+                },
+                "verified": {
+                    "description": "Whole chain is intact, only set with events",
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.DecideClaimRequest": {
+            "type": "object",
+            "required": [
+                "status"
             ],
             "properties": {
-                "name": {
+                "reason": {
+                    "description": "Shown to the claimant when rejecting",
+                    "type": "string",
+                    "example": "The answer does not match the item"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "APPROVED",
+                        "REJECTED"
+                    ]
+                }
+            }
+        },
+        "dto.DisposalQueueResponse": {
+            "type": "object",
+            "properties": {
+                "category_name": {
+                    "type": "string"
+                },
+                "desk_id": {
+                    "description": "Set when the item is held at a security desk",
+                    "type": "string"
+                },
+                "desk_name": {
+                    "type": "string"
+                },
+                "finder_name": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "location_name": {
+                    "description": "Where it was found",
+                    "type": "string"
+                },
+                "reported_at": {
+                    "type": "string"
+                },
+                "retention_days": {
+                    "type": "integer"
+                },
+                "retention_ended_at": {
+                    "type": "string"
+                },
+                "storage_bin": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "controllers.CreateLocationRequest": {
+        "dto.DisposalResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "disposition": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "item_status": {
+                    "type": "string"
+                },
+                "item_title": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "recipient": {
+                    "type": "string"
+                },
+                "recorded_by_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.DisposeItemRequest": {
             "type": "object",
             "required": [
-                "latitude",
-                "longitude",
-                "name"
+                "disposition",
+                "item_id"
             ],
             "properties": {
-                "latitude": {
-                    "type": "number"
+                "disposition": {
+                    "type": "string",
+                    "enum": [
+                        "DONATED",
+                        "DISPOSED",
+                        "TRANSFERRED"
+                    ]
                 },
-                "longitude": {
-                    "type": "number"
+                "item_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "name": {
+                "note": {
+                    "type": "string",
+                    "example": "Donated with the semester clothing batch"
+                },
+                "recipient": {
+                    "description": "Charity, agency or waste service",
+                    "type": "string",
+                    "example": "Rumah Zakat Yogyakarta"
+                }
+            }
+        },
+        "dto.FoundEventReplyRequest": {
+            "type": "object",
+            "required": [
+                "message"
+            ],
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Thank you! Could you leave it at the FTI security desk?"
+                }
+            }
+        },
+        "dto.HandoverRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Typed code or the scanned QR content",
+                    "type": "string",
+                    "example": "482913"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Checked the claimant's KTM"
+                }
+            }
+        },
+        "dto.HandoverResponse": {
+            "type": "object",
+            "properties": {
+                "claim_id": {
+                    "type": "string"
+                },
+                "claim_status": {
+                    "type": "string"
+                },
+                "claimant_id": {
+                    "type": "string"
+                },
+                "handed_over_at": {
+                    "type": "string"
+                },
+                "handed_over_by_id": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "item_status": {
                     "type": "string"
                 }
             }
         },
-        "dto.AssetResponse": {
+        "dto.ItemResponse": {
             "type": "object",
             "properties": {
+                "auto_approve": {
+                    "description": "Only shown to the finder",
+                    "type": "boolean"
+                },
                 "category_id": {
                     "type": "string"
                 },
-                "category_name": {
+                "category_name": {
+                    "type": "string"
+                },
+                "contacts": {
+                    "description": "For both item types",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ContactResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "date_found": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "date_lost": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "finder": {
+                    "$ref": "#/definitions/dto.ItemUserResponse"
+                },
+                "id": {
                     "type": "string"
                 },
-                "created_at": {
+                "image_url": {
                     "type": "string"
                 },
-                "description": {
+                "location_id": {
                     "type": "string"
                 },
-                "id": {
+                "location_name": {
                     "type": "string"
                 },
-                "lost_mode": {
+                "offer_reward": {
+                    "description": "For lost items",
                     "type": "boolean"
                 },
-                "owner_id": {
+                "owner": {
+                    "$ref": "#/definitions/dto.ItemUserResponse"
+                },
+                "show_phone": {
+                    "description": "For both item types",
+                    "type": "boolean"
+                },
+                "status": {
                     "type": "string"
                 },
-                "private_image_url": {
-                    "description": "Only for owner",
+                "title": {
                     "type": "string"
                 },
-                "qr_code_url": {
+                "type": {
+                    "description": "LOST/FOUND",
                     "type": "string"
-                }
-            }
-        },
-        "dto.AuthResponse": {
-            "type": "object",
-            "properties": {
-                "refresh_token": {
+                },
+                "urgency": {
+                    "description": "For lost items",
                     "type": "string"
                 },
-                "token": {
+                "user_claim_status": {
+                    "description": "Status of claim by current user",
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/dto.UserResponse"
+                "verifications": {
+                    "description": "For found items",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VerificationResponse"
+                    }
                 }
             }
         },
-        "dto.ClaimResponse": {
+        "dto.ItemStatusHistoryResponse": {
             "type": "object",
             "properties": {
-                "answer_input": {
+                "actor": {
+                    "description": "FINDER, OWNER, SECURITY, CLAIM or SYSTEM",
                     "type": "string"
                 },
-                "claimer": {
-                    "$ref": "#/definitions/dto.ItemUserResponse"
+                "actor_id": {
+                    "type": "string"
+                },
+                "actor_name": {
+                    "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
+                "from_status": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
-                "image_url": {
+                "reason": {
                     "type": "string"
                 },
-                "item_id": {
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ItemUserResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "owner_id": {
+                "name": {
                     "type": "string"
                 },
-                "status": {
+                "role": {
                     "type": "string"
                 }
             }
         },
-        "dto.ContactRequest": {
+        "dto.LoginRequest": {
             "type": "object",
             "required": [
-                "platform",
-                "value"
+                "email",
+                "password"
             ],
             "properties": {
-                "platform": {
+                "email": {
                     "type": "string",
-                    "example": "WHATSAPP"
+                    "example": "john@students.uii.ac.id"
                 },
-                "value": {
+                "password": {
                     "type": "string",
-                    "example": "08123456789"
+                    "example": "password123"
                 }
             }
         },
-        "dto.ContactResponse": {
+        "dto.MatchResponse": {
             "type": "object",
             "properties": {
-                "platform": {
+                "breakdown": {
+                    "$ref": "#/definitions/models.MatchBreakdown"
+                },
+                "claim_id": {
                     "type": "string"
                 },
-                "value": {
+                "created_at": {
+                    "type": "string"
+                },
+                "explanation": {
+                    "type": "string"
+                },
+                "found_item_id": {
+                    "type": "string"
+                },
+                "found_title": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lost_asset_id": {
+                    "type": "string"
+                },
+                "lost_item_id": {
+                    "type": "string"
+                },
+                "lost_title": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "dto.CreateAssetRequest": {
+        "dto.MyClaimResponse": {
             "type": "object",
-            "required": [
-                "category_id",
-                "description"
-            ],
             "properties": {
-                "category_id": {
+                "appeal_reason": {
                     "type": "string"
                 },
-                "description": {
+                "appealed_at": {
                     "type": "string"
                 },
-                "lost_mode": {
+                "auto_approved": {
                     "type": "boolean"
                 },
-                "private_image_url": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "item_status": {
+                    "type": "string"
+                },
+                "item_title": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "review_note": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "PENDING, APPROVED, REJECTED, ESCALATED, WITHDRAWN or COMPLETED",
                     "type": "string"
                 }
             }
         },
-        "dto.CreateClaimRequest": {
+        "dto.Page": {
             "type": "object",
-            "required": [
-                "answer_input"
-            ],
             "properties": {
-                "answer_input": {
-                    "type": "string",
-                    "example": "Blue wallet with university ID"
+                "data": {},
+                "next_cursor": {
+                    "description": "Pass as ?cursor= to get the next page, empty on the last page",
+                    "type": "string"
                 },
-                "image_url": {
+                "total": {
+                    "description": "Total rows matching the filters, across all pages",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.PickupCodeResponse": {
+            "type": "object",
+            "properties": {
+                "attempts_left": {
+                    "type": "integer"
+                },
+                "claim_id": {
+                    "type": "string"
+                },
+                "code": {
                     "type": "string",
-                    "example": "http://example.com/proof.jpg"
+                    "example": "482913"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "string"
+                },
+                "qr_code": {
+                    "description": "PNG data URL of the same code, for scanning at handover",
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateFoundItemRequest": {
+        "dto.PublicItemResponse": {
             "type": "object",
-            "required": [
-                "category_id",
-                "date_found",
-                "location_id",
-                "return_method",
-                "title",
-                "verifications"
-            ],
             "properties": {
                 "category_id": {
-                    "type": "string",
-                    "example": "1bd43cf7-fc4f-4968-bd4f-c45699b03c18"
+                    "type": "string"
                 },
-                "cod": {
-                    "type": "boolean",
-                    "example": false
+                "category_name": {
+                    "type": "string"
                 },
                 "contacts": {
+                    "description": "Only when the reporter enabled show_phone",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ContactRequest"
+                        "$ref": "#/definitions/dto.ContactResponse"
                     }
                 },
+                "created_at": {
+                    "type": "string"
+                },
                 "date_found": {
-                    "description": "Format YYYY-MM-DD or RFC3339",
-                    "type": "string",
-                    "example": "2023-10-27"
+                    "type": "string"
                 },
-                "image_url": {
-                    "type": "string",
-                    "example": "http://example.com/image.jpg"
+                "date_lost": {
+                    "type": "string"
                 },
-                "location_id": {
-                    "type": "string",
-                    "example": "e9464495-bfe5-4ed0-8ea4-a2d69afa0b39"
+                "description": {
+                    "type": "string"
                 },
-                "return_method": {
-                    "type": "string",
-                    "enum": [
-                        "BRING_BY_FINDER",
-                        "HANDED_TO_SECURITY"
-                    ],
-                    "example": "BRING_BY_FINDER"
+                "id": {
+                    "type": "string"
                 },
-                "show_phone": {
-                    "type": "boolean",
-                    "example": false
+                "kind": {
+                    "description": "ITEM or ASSET",
+                    "type": "string"
+                },
+                "location_name": {
+                    "type": "string"
+                },
+                "offer_reward": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "thumbnail_url": {
+                    "description": "Small blurred preview, only for uploaded images",
+                    "type": "string"
                 },
                 "title": {
-                    "type": "string",
-                    "example": "Blue Wallet"
+                    "type": "string"
                 },
-                "verifications": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.VerificationRequest"
-                    }
+                "type": {
+                    "description": "LOST or FOUND",
+                    "type": "string"
+                },
+                "urgency": {
+                    "type": "string"
                 }
             }
         },
-        "dto.CreateLostItemRequest": {
+        "dto.RefreshTokenRequest": {
             "type": "object",
             "required": [
-                "category_id",
-                "date_lost",
-                "location_last_seen",
-                "title"
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "identity_number",
+                "name",
+                "password",
+                "phone"
             ],
             "properties": {
-                "category_id": {
+                "email": {
                     "type": "string",
-                    "example": "1bd43cf7-fc4f-4968-bd4f-c45699b03c18"
-                },
-                "contacts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.ContactRequest"
-                    }
+                    "example": "john@students.uii.ac.id"
                 },
-                "date_lost": {
-                    "description": "Format YYYY-MM-DD",
+                "faculty": {
+                    "description": "Optional, empty for Staff/Dosen",
                     "type": "string",
-                    "example": "2023-10-26"
+                    "example": "Fakultas Teknologi Industri"
                 },
-                "description": {
+                "identity_number": {
                     "type": "string",
-                    "example": "Black case with a sticker"
+                    "example": "21523001"
                 },
-                "image_url": {
+                "name": {
                     "type": "string",
-                    "example": "http://example.com/iphone.jpg"
+                    "example": "John Doe"
                 },
-                "location_last_seen": {
+                "password": {
                     "type": "string",
-                    "example": "Canteen"
-                },
-                "offer_reward": {
-                    "type": "boolean",
-                    "example": true
-                },
-                "show_phone": {
-                    "type": "boolean",
-                    "example": false
+                    "minLength": 6,
+                    "example": "password123"
                 },
-                "title": {
+                "phone": {
                     "type": "string",
-                    "example": "iPhone 13"
+                    "example": "08123456789"
                 },
-                "urgency": {
+                "role": {
+                    "description": "Optional, auto-assigned from the email domain when empty",
                     "type": "string",
                     "enum": [
-                        "NORMAL",
-                        "HIGH",
-                        "CRITICAL"
+                        "PUBLIK",
+                        "MAHASISWA",
+                        "STAFF_DOSEN"
                     ],
-                    "example": "HIGH"
+                    "example": "MAHASISWA"
                 }
             }
         },
-        "dto.DecideClaimRequest": {
+        "dto.ReportFoundRequest": {
+            "type": "object",
+            "required": [
+                "location_id"
+            ],
+            "properties": {
+                "contact_handle": {
+                    "description": "Guests only, ignored when signed in",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "@finder_on_telegram"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "location_id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ReviewClaimRequest": {
             "type": "object",
             "required": [
+                "note",
                 "status"
             ],
             "properties": {
+                "note": {
+                    "description": "Final decision, shown to the claimant",
+                    "type": "string",
+                    "example": "Receipt confirms ownership"
+                },
                 "status": {
                     "type": "string",
                     "enum": [
@@ -1621,61 +5988,117 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.ItemResponse": {
+        "dto.ScanChallenge": {
             "type": "object",
             "properties": {
-                "category_id": {
+                "expires_at": {
                     "type": "string"
                 },
-                "category_name": {
-                    "type": "string"
+                "question": {
+                    "type": "string",
+                    "example": "What is 3 + 4?"
                 },
-                "contacts": {
-                    "description": "For both item types",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.ContactResponse"
-                    }
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ScanReportFoundRequest": {
+            "type": "object",
+            "required": [
+                "challenge_answer",
+                "challenge_token",
+                "location_id"
+            ],
+            "properties": {
+                "challenge_answer": {
+                    "type": "string",
+                    "example": "7"
                 },
-                "created_at": {
+                "challenge_token": {
                     "type": "string"
                 },
-                "date_found": {
-                    "description": "YYYY-MM-DD",
+                "contact_handle": {
+                    "description": "Ignored when signed in",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "@finder_on_telegram"
+                },
+                "location_id": {
+                    "type": "string",
+                    "example": "e9464495-bfe5-4ed0-8ea4-a2d69afa0b39"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Left it with the librarian"
+                }
+            }
+        },
+        "dto.ScanResponse": {
+            "type": "object",
+            "properties": {
+                "asset_id": {
                     "type": "string"
                 },
-                "date_lost": {
-                    "description": "YYYY-MM-DD",
+                "category_name": {
                     "type": "string"
                 },
-                "description": {
+                "challenge": {
+                    "description": "Must be answered to report the asset found without an account",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ScanChallenge"
+                        }
+                    ]
+                },
+                "lost_mode": {
+                    "type": "boolean"
+                },
+                "message": {
                     "type": "string"
                 },
-                "finder": {
-                    "$ref": "#/definitions/dto.ItemUserResponse"
+                "nearest_desk": {
+                    "description": "Only set when the scanner shared lat and lng",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.SecurityDeskResponse"
+                        }
+                    ]
+                },
+                "security_desks": {
+                    "description": "Every security desk, closest first when the scanner shared coordinates",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SecurityDeskResponse"
+                    }
+                }
+            }
+        },
+        "dto.SearchResultResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "image_url": {
+                "kind": {
+                    "description": "ITEM or ASSET",
                     "type": "string"
                 },
                 "location_id": {
                     "type": "string"
                 },
-                "location_name": {
+                "occurred_at": {
                     "type": "string"
                 },
-                "offer_reward": {
-                    "description": "For lost items",
-                    "type": "boolean"
-                },
-                "owner": {
-                    "$ref": "#/definitions/dto.ItemUserResponse"
+                "rank": {
+                    "type": "number"
                 },
-                "show_phone": {
-                    "description": "For both item types",
-                    "type": "boolean"
+                "snippet": {
+                    "description": "HTML-escaped, matched terms wrapped in \u003cmark\u003e\u003c/mark\u003e",
+                    "type": "string"
                 },
                 "status": {
                     "type": "string"
@@ -1688,135 +6111,224 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "urgency": {
-                    "description": "For lost items",
-                    "type": "string"
-                },
-                "user_claim_status": {
-                    "description": "Status of claim by current user",
                     "type": "string"
-                },
-                "verifications": {
-                    "description": "For found items",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.VerificationResponse"
-                    }
                 }
             }
         },
-        "dto.ItemUserResponse": {
+        "dto.SecurityDeskResponse": {
             "type": "object",
             "properties": {
-                "id": {
+                "description": {
                     "type": "string"
                 },
-                "name": {
+                "distance_m": {
+                    "type": "number"
+                },
+                "id": {
                     "type": "string"
                 },
-                "role": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.TransferAssetRequest": {
             "type": "object",
             "required": [
-                "email",
-                "password"
+                "recipient_email"
             ],
             "properties": {
-                "email": {
+                "note": {
                     "type": "string",
-                    "example": "john@students.uii.ac.id"
+                    "maxLength": 500,
+                    "example": "Sold to you, charger included"
                 },
-                "password": {
+                "recipient_email": {
                     "type": "string",
-                    "example": "password123"
+                    "example": "budi@student.univ.ac.id"
                 }
             }
         },
-        "dto.RefreshTokenRequest": {
+        "dto.TransferCustodyRequest": {
             "type": "object",
             "required": [
-                "refresh_token"
+                "desk_id",
+                "storage_bin"
             ],
             "properties": {
-                "refresh_token": {
-                    "type": "string"
+                "desk_id": {
+                    "description": "Same desk to only move bins",
+                    "type": "string",
+                    "example": "90880496-c0de-4af4-bb06-30e1e0ec9a53"
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Moved to the main desk for weekend storage"
+                },
+                "storage_bin": {
+                    "type": "string",
+                    "example": "Locker 4"
                 }
             }
         },
-        "dto.RegisterRequest": {
+        "dto.UpdateAssetRequest": {
             "type": "object",
-            "required": [
-                "email",
-                "identity_number",
-                "name",
-                "password",
-                "phone"
-            ],
             "properties": {
-                "email": {
+                "category_id": {
                     "type": "string",
-                    "example": "john@students.uii.ac.id"
+                    "example": "e9464495-bfe5-4ed0-8ea4-a2d69afa0b39"
                 },
-                "faculty": {
-                    "description": "Optional, empty for Staff/Dosen",
+                "description": {
                     "type": "string",
-                    "example": "Fakultas Teknologi Industri"
+                    "example": "Silver ThinkPad X1 with a cracked corner"
                 },
-                "identity_number": {
+                "private_image_url": {
                     "type": "string",
-                    "example": "21523001"
+                    "example": "http://example.com/thinkpad.jpg"
+                }
+            }
+        },
+        "dto.UpdateItemRequest": {
+            "type": "object",
+            "properties": {
+                "auto_approve": {
+                    "description": "Found items, finder only. Unchanged when omitted",
+                    "type": "boolean",
+                    "example": true
                 },
-                "name": {
+                "contacts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ContactRequest"
+                    }
+                },
+                "date_found": {
+                    "description": "Format YYYY-MM-DD",
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "2023-10-27"
+                },
+                "date_lost": {
+                    "description": "Format YYYY-MM-DD",
+                    "type": "string",
+                    "example": "2023-10-26"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Black case with a sticker"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "http://example.com/iphone.jpg"
+                },
+                "location_last_seen": {
+                    "type": "string",
+                    "example": "Canteen"
+                },
+                "offer_reward": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6,
-                    "example": "password123"
+                "show_phone": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "phone": {
+                "title": {
                     "type": "string",
-                    "example": "08123456789"
+                    "example": "iPhone 13"
                 },
-                "role": {
+                "urgency": {
                     "type": "string",
                     "enum": [
-                        "PUBLIK",
-                        "MAHASISWA",
-                        "STAFF_DOSEN",
-                        "ADMIN",
-                        "SECURITY"
+                        "NORMAL",
+                        "HIGH",
+                        "CRITICAL"
                     ],
-                    "example": "MAHASISWA"
+                    "example": "HIGH"
                 }
             }
         },
-        "dto.ReportFoundRequest": {
+        "dto.UpdateItemStatusRequest": {
             "type": "object",
             "required": [
-                "location_id"
+                "status"
             ],
             "properties": {
-                "image_url": {
-                    "type": "string"
-                },
-                "location_id": {
-                    "type": "string"
+                "reason": {
+                    "description": "Kept in the item's status history",
+                    "type": "string",
+                    "example": "Owner picked it up in person"
                 },
-                "note": {
-                    "type": "string"
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "OPEN",
+                        "CLAIMED",
+                        "RESOLVED",
+                        "EXPIRED",
+                        "ARCHIVED"
+                    ]
                 }
             }
         },
         "dto.UpdateLostModeRequest": {
             "type": "object",
             "properties": {
+                "last_seen_description": {
+                    "description": "Optional",
+                    "type": "string",
+                    "example": "Left it on a desk in the library"
+                },
+                "last_seen_location_id": {
+                    "description": "Optional",
+                    "type": "string",
+                    "example": "e9464495-bfe5-4ed0-8ea4-a2d69afa0b39"
+                },
                 "lost_mode": {
                     "type": "boolean"
+                },
+                "lost_since": {
+                    "description": "Optional, YYYY-MM-DD or RFC3339, defaults to now",
+                    "type": "string",
+                    "example": "2023-10-26"
+                }
+            }
+        },
+        "dto.UpdateMatchRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "answer_input": {
+                    "description": "Used for the prefilled claim",
+                    "type": "string",
+                    "example": "Blue wallet with university ID"
+                },
+                "answers": {
+                    "description": "Answers to the found item's verification questions",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClaimAnswerRequest"
+                    }
+                },
+                "image_url": {
+                    "description": "Shown to the finder, never prefilled from a lost asset's private image",
+                    "type": "string",
+                    "example": "http://example.com/proof.jpg"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ACCEPTED",
+                        "DISMISSED"
+                    ],
+                    "example": "ACCEPTED"
                 }
             }
         },
@@ -1902,11 +6414,41 @@ const docTemplate = `{
         "dto.VerificationResponse": {
             "type": "object",
             "properties": {
+                "id": {
+                    "description": "Referenced by claim answers",
+                    "type": "string"
+                },
                 "question": {
                     "type": "string"
                 }
             }
         },
+        "models.AnswerMatch": {
+            "type": "string",
+            "enum": [
+                "EXACT",
+                "CLOSE",
+                "PARTIAL",
+                "NO_MATCH"
+            ],
+            "x-enum-comments": {
+                "AnswerMatchClose": "Typos or extra words around the right answer",
+                "AnswerMatchExact": "Same answer after normalizing case, accents and punctuation",
+                "AnswerMatchPartial": "Some overlap, worth asking the claimant about"
+            },
+            "x-enum-descriptions": [
+                "Same answer after normalizing case, accents and punctuation",
+                "Typos or extra words around the right answer",
+                "Some overlap, worth asking the claimant about",
+                ""
+            ],
+            "x-enum-varnames": [
+                "AnswerMatchExact",
+                "AnswerMatchClose",
+                "AnswerMatchPartial",
+                "AnswerMatchNone"
+            ]
+        },
         "models.Asset": {
             "type": "object",
             "properties": {
@@ -1925,6 +6467,12 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "lost_episodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AssetLostEpisode"
+                    }
+                },
                 "lost_mode": {
                     "type": "boolean"
                 },
@@ -1946,6 +6494,38 @@ const docTemplate = `{
                 }
             }
         },
+        "models.AssetLostEpisode": {
+            "type": "object",
+            "properties": {
+                "asset_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "ended_at": {
+                    "description": "Nil while the asset is still lost",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.CampusLocation"
+                },
+                "location_description": {
+                    "description": "Free text, optional",
+                    "type": "string"
+                },
+                "location_id": {
+                    "description": "Last seen campus location, optional",
+                    "type": "string"
+                },
+                "lost_since": {
+                    "type": "string"
+                }
+            }
+        },
         "models.CampusLocation": {
             "type": "object",
             "properties": {
@@ -1955,6 +6535,10 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "is_security_desk": {
+                    "description": "Staffed security post that takes found items; anonymous finders are pointed to the nearest one",
+                    "type": "boolean"
+                },
                 "latitude": {
                     "type": "number"
                 },
@@ -1972,6 +6556,26 @@ const docTemplate = `{
                 "answer_input": {
                     "type": "string"
                 },
+                "answer_score": {
+                    "description": "Average of the per-question scores, 0..1",
+                    "type": "number"
+                },
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ClaimAnswer"
+                    }
+                },
+                "appeal_reason": {
+                    "type": "string"
+                },
+                "appealed_at": {
+                    "type": "string"
+                },
+                "auto_approved": {
+                    "description": "Approved on submission because every answer matched exactly",
+                    "type": "boolean"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -1994,6 +6598,20 @@ const docTemplate = `{
                 "owner_id": {
                     "type": "string"
                 },
+                "reason": {
+                    "description": "Why the claim was rejected, shown to the claimant",
+                    "type": "string"
+                },
+                "review_note": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "description": "SECURITY or ADMIN who decided the appeal",
+                    "type": "string"
+                },
                 "status": {
                     "$ref": "#/definitions/models.ClaimStatus"
                 },
@@ -2002,17 +6620,66 @@ const docTemplate = `{
                 }
             }
         },
+        "models.ClaimAnswer": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "string"
+                },
+                "claim_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "match": {
+                    "$ref": "#/definitions/models.AnswerMatch"
+                },
+                "question": {
+                    "description": "Copied so later edits to the question keep the claim readable",
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "verification_id": {
+                    "type": "string"
+                }
+            }
+        },
         "models.ClaimStatus": {
             "type": "string",
             "enum": [
                 "PENDING",
                 "APPROVED",
-                "REJECTED"
+                "REJECTED",
+                "COMPLETED",
+                "WITHDRAWN",
+                "ESCALATED"
+            ],
+            "x-enum-comments": {
+                "ClaimStatusCompleted": "Item handed over to the claimant",
+                "ClaimStatusEscalated": "Rejection appealed, waiting for a SECURITY or ADMIN reviewer",
+                "ClaimStatusWithdrawn": "Taken back by the claimant"
+            },
+            "x-enum-descriptions": [
+                "",
+                "",
+                "",
+                "Item handed over to the claimant",
+                "Taken back by the claimant",
+                "Rejection appealed, waiting for a SECURITY or ADMIN reviewer"
             ],
             "x-enum-varnames": [
                 "ClaimStatusPending",
                 "ClaimStatusApproved",
-                "ClaimStatusRejected"
+                "ClaimStatusRejected",
+                "ClaimStatusCompleted",
+                "ClaimStatusWithdrawn",
+                "ClaimStatusEscalated"
             ]
         },
         "models.FoundEvent": {
@@ -2024,6 +6691,10 @@ const docTemplate = `{
                 "asset_id": {
                     "type": "string"
                 },
+                "contact_handle": {
+                    "description": "Guest reports only: how the finder asked to be reached (e-mail, phone, @handle)",
+                    "type": "string"
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -2031,7 +6702,7 @@ const docTemplate = `{
                     "$ref": "#/definitions/models.User"
                 },
                 "finder_id": {
-                    "description": "Can be nil if anonymous scan? No, prompt says FinderID FK. Assuming authenticated finder or security.",
+                    "description": "Nil for guest reports",
                     "type": "string"
                 },
                 "id": {
@@ -2040,6 +6711,9 @@ const docTemplate = `{
                 "image_url": {
                     "type": "string"
                 },
+                "is_spam": {
+                    "type": "boolean"
+                },
                 "location": {
                     "$ref": "#/definitions/models.CampusLocation"
                 },
@@ -2048,12 +6722,19 @@ const docTemplate = `{
                 },
                 "note": {
                     "type": "string"
+                },
+                "spam_marked_at": {
+                    "type": "string"
                 }
             }
         },
         "models.Item": {
             "type": "object",
             "properties": {
+                "auto_approve": {
+                    "description": "Approve claims whose answers all match exactly (Found items)",
+                    "type": "boolean"
+                },
                 "category": {
                     "$ref": "#/definitions/models.ItemCategory"
                 },
@@ -2152,6 +6833,10 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "retention_days": {
+                    "description": "How long unclaimed found items are kept, 0 uses ITEM_RETENTION_DAYS",
+                    "type": "integer"
                 }
             }
         },
@@ -2183,12 +6868,27 @@ const docTemplate = `{
             "enum": [
                 "OPEN",
                 "CLAIMED",
-                "RESOLVED"
+                "RESOLVED",
+                "EXPIRED",
+                "ARCHIVED"
+            ],
+            "x-enum-comments": {
+                "ItemStatusArchived": "Closed for good: withdrawn, disposed or filed away after return",
+                "ItemStatusExpired": "Retention period ran out without a claim"
+            },
+            "x-enum-descriptions": [
+                "",
+                "",
+                "",
+                "Retention period ran out without a claim",
+                "Closed for good: withdrawn, disposed or filed away after return"
             ],
             "x-enum-varnames": [
                 "ItemStatusOpen",
                 "ItemStatusClaimed",
-                "ItemStatusResolved"
+                "ItemStatusResolved",
+                "ItemStatusExpired",
+                "ItemStatusArchived"
             ]
         },
         "models.ItemType": {
@@ -2235,6 +6935,111 @@ const docTemplate = `{
                 }
             }
         },
+        "models.Job": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "locked_at": {
+                    "description": "Claim time, refreshed by the worker's heartbeat while it runs",
+                    "type": "string"
+                },
+                "locked_by": {
+                    "description": "Set per claim; only that claim may finish the job",
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "run_at": {
+                    "description": "Not picked up before this time (backoff)",
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.JobStatus"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.JobStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "RUNNING",
+                "DONE",
+                "DEAD"
+            ],
+            "x-enum-comments": {
+                "JobStatusDead": "Gave up after MaxAttempts, needs an admin to re-queue"
+            },
+            "x-enum-descriptions": [
+                "",
+                "",
+                "",
+                "Gave up after MaxAttempts, needs an admin to re-queue"
+            ],
+            "x-enum-varnames": [
+                "JobStatusPending",
+                "JobStatusRunning",
+                "JobStatusDone",
+                "JobStatusDead"
+            ]
+        },
+        "models.MatchBreakdown": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "$ref": "#/definitions/models.MatchSignal"
+                },
+                "distance_m": {
+                    "type": "number"
+                },
+                "location": {
+                    "$ref": "#/definitions/models.MatchSignal"
+                },
+                "text": {
+                    "$ref": "#/definitions/models.MatchSignal"
+                },
+                "time": {
+                    "$ref": "#/definitions/models.MatchSignal"
+                },
+                "time_gap_hours": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "number"
+                }
+            }
+        },
+        "models.MatchSignal": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "boolean"
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
         "models.Notification": {
             "type": "object",
             "properties": {
@@ -2297,6 +7102,41 @@ const docTemplate = `{
                 "ReturnMethodHandedToSecurity"
             ]
         },
+        "models.RoleChange": {
+            "type": "object",
+            "properties": {
+                "changed_by_id": {
+                    "description": "Nil for the bootstrap CLI",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "new_role": {
+                    "$ref": "#/definitions/models.UserRole"
+                },
+                "old_role": {
+                    "description": "Empty when the account was created with the role",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.UserRole"
+                        }
+                    ]
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
         "models.User": {
             "type": "object",
             "properties": {
@@ -3,8 +3,11 @@ package controllers
 import (
 	"campus-lost-and-found/internal/dto"
 	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/pagination"
 	"campus-lost-and-found/internal/services"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -75,20 +78,72 @@ func (ctrl *AssetController) GetAsset(c *gin.Context) {
 		CategoryName: asset.Category.Name,
 		Description:  asset.Description,
 		LostMode:     asset.LostMode,
-		QRCodeURL:    asset.QRCodeURL,
 		CreatedAt:    asset.CreatedAt,
 	}
 
 	if isOwner {
 		res.PrivateImageURL = asset.PrivateImageURL
+		res.QRCodeURL = asset.QRCodeURL
+		res.ScanURL = services.ScanURL(asset)
 	}
 
 	c.JSON(http.StatusOK, res)
 }
 
+// UpdateAsset godoc
+// @Summary Edit an asset
+// @Description Change the category, description or private image of an asset. Empty fields are left unchanged (owner only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param request body dto.UpdateAssetRequest true "Update Asset Request"
+// @Success 200 {object} dto.AssetResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id} [put]
+func (ctrl *AssetController) UpdateAsset(c *gin.Context) {
+	var req dto.UpdateAssetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.UpdateAsset(c.Param("id"), req, userID)
+	if err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DeleteAsset godoc
+// @Summary Delete an asset
+// @Description Delete an asset and revoke its QR code, so printed stickers stop working. Lost mode, a pending transfer and open match suggestions are closed; found reports are kept (owner only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id} [delete]
+func (ctrl *AssetController) DeleteAsset(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if err := ctrl.Service.DeleteAsset(c.Param("id"), userID); err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Asset deleted, its QR code no longer works"})
+}
+
 // UpdateLostMode godoc
 // @Summary Update lost mode
-// @Description Enable or disable lost mode
+// @Description Enable or disable lost mode, optionally with last-seen location, description and lost-since time. While lost mode is on, fields left out keep their current value
 // @Tags assets
 // @Accept json
 // @Produce json
@@ -97,6 +152,8 @@ func (ctrl *AssetController) GetAsset(c *gin.Context) {
 // @Param request body dto.UpdateLostModeRequest true "Update Lost Mode Request"
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
 // @Router /assets/{id}/lost-mode [put]
 func (ctrl *AssetController) UpdateLostMode(c *gin.Context) {
 	id := c.Param("id")
@@ -107,25 +164,113 @@ func (ctrl *AssetController) UpdateLostMode(c *gin.Context) {
 	}
 
 	userID := middleware.GetUserID(c)
-	err := ctrl.Service.UpdateLostMode(id, req.LostMode, userID)
+	err := ctrl.Service.UpdateLostMode(id, req, userID)
 	if err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		assetError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{"message": "Lost mode updated"})
 }
 
+// GetLabels godoc
+// @Summary Print QR sticker sheets
+// @Description Render the QR codes of your assets on print-ready sticker sheets, each label with the category and an "If found, scan me" message. One label per ID, in order; repeat an ID for several stickers. PDFs span as many sheets as needed, an SVG holds one sheet. Print at 100% scale
+// @Tags assets
+// @Produce application/pdf
+// @Produce image/svg+xml
+// @Security BearerAuth
+// @Param ids query string true "Comma-separated asset IDs (at most 200)"
+// @Param layout query string false "Sticker paper (default a4-3x8)" Enums(a4-3x8, a4-2x7, a4-4x10, letter-3x10)
+// @Param format query string false "Output format (default pdf)" Enums(pdf, svg)
+// @Param ecc query string false "QR error correction level (default H)" Enums(L, M, Q, H)
+// @Success 200 {file} binary
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/labels [get]
+func (ctrl *AssetController) GetLabels(c *gin.Context) {
+	var ids []string
+	for _, id := range strings.Split(c.Query("ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	format := strings.ToLower(c.DefaultQuery("format", "pdf"))
+
+	userID := middleware.GetUserID(c)
+	sheet, err := ctrl.Service.RenderLabels(ids, c.DefaultQuery("layout", "a4-3x8"), format, strings.ToUpper(c.DefaultQuery("ecc", "H")), userID)
+	if err != nil {
+		assetError(c, err)
+		return
+	}
+
+	contentType := "application/pdf"
+	if format == "svg" {
+		contentType = "image/svg+xml"
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="asset-labels.%s"`, format))
+	c.Data(http.StatusOK, contentType, sheet)
+}
+
+// RotateQR godoc
+// @Summary Rotate an asset's QR code
+// @Description Revoke every printed QR sticker of the asset, e.g. after one was stolen, and render a new code. qr_code_url is empty until the new image is ready (owner only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id}/qr/rotate [post]
+func (ctrl *AssetController) RotateQR(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if err := ctrl.Service.RotateQR(c.Param("id"), userID); err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "QR code rotated, old stickers no longer work"})
+}
+
+// GetLostHistory godoc
+// @Summary Get lost-mode history for an asset
+// @Description Get every lost-mode episode of an asset with its last-seen context (owner only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {object} []models.AssetLostEpisode
+// @Failure 403 {object} map[string]string
+// @Router /assets/{id}/lost-history [get]
+func (ctrl *AssetController) GetLostHistory(c *gin.Context) {
+	id := c.Param("id")
+	userID := middleware.GetUserID(c)
+	episodes, err := ctrl.Service.GetLostHistory(id, userID)
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, episodes)
+}
+
 // ReportFound godoc
 // @Summary Report asset found (Scan QR)
-// @Description Report that an asset has been found by scanning QR
+// @Description Report that an asset has been found. The finder is recorded on the report and can talk to the owner through it. Guests report through the scan page instead (POST /scan/{id}/report-found). Rate limited per IP and per asset; reporters the owner marked as spam are refused
 // @Tags assets
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "Asset ID"
 // @Param request body dto.ReportFoundRequest true "Report Found Request"
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 429 {object} map[string]string
 // @Router /assets/{id}/report-found [post]
 func (ctrl *AssetController) ReportFound(c *gin.Context) {
 	id := c.Param("id")
@@ -135,9 +280,10 @@ func (ctrl *AssetController) ReportFound(c *gin.Context) {
 		return
 	}
 
-	err := ctrl.Service.ReportFound(id, req)
+	userID := middleware.GetUserID(c)
+	err := ctrl.Service.ReportFound(id, req, &userID, c.ClientIP())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		assetError(c, err)
 		return
 	}
 
@@ -146,36 +292,234 @@ func (ctrl *AssetController) ReportFound(c *gin.Context) {
 
 // GetFoundEvents godoc
 // @Summary Get found events for an asset
-// @Description Get history of found events
+// @Description Get the found reports on an asset, newest first, with spam flags and guest contacts (owner only)
 // @Tags assets
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "Asset ID"
 // @Success 200 {object} []models.FoundEvent
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Router /assets/{id}/found-events [get]
 func (ctrl *AssetController) GetFoundEvents(c *gin.Context) {
 	id := c.Param("id")
-	events, err := ctrl.Service.GetFoundEvents(id)
+	userID := middleware.GetUserID(c)
+	events, err := ctrl.Service.GetFoundEvents(id, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		assetError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, events)
 }
 
+// ReplyToFoundEvent godoc
+// @Summary Reply on a found report
+// @Description Relay a message between the owner and a signed-in finder as a notification, without sharing contact details. Guest reports are answered through the contact they left
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param event_id path string true "Found Event ID"
+// @Param request body dto.FoundEventReplyRequest true "Reply Request"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id}/found-events/{event_id}/reply [post]
+func (ctrl *AssetController) ReplyToFoundEvent(c *gin.Context) {
+	var req dto.FoundEventReplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	if err := ctrl.Service.ReplyToFoundEvent(c.Param("id"), c.Param("event_id"), req, userID); err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Message sent"})
+}
+
+// MarkFoundEventSpam godoc
+// @Summary Mark a found report as spam
+// @Description Flag a found report as spam. It stays in the history, and its reporter (the account, or the address of a guest) can no longer report this asset (owner only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param event_id path string true "Found Event ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id}/found-events/{event_id}/spam [put]
+func (ctrl *AssetController) MarkFoundEventSpam(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if err := ctrl.Service.MarkFoundEventSpam(c.Param("id"), c.Param("event_id"), userID); err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Report marked as spam"})
+}
+
+// TransferAsset godoc
+// @Summary Transfer an asset to another user
+// @Description Offer an asset to another account by email, e.g. after selling it. Ownership moves once the recipient accepts; found reports, lost history and the QR code stay with the asset. Lost mode must be off (owner only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param request body dto.TransferAssetRequest true "Transfer Asset Request"
+// @Success 200 {object} dto.AssetTransferResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/{id}/transfer [post]
+func (ctrl *AssetController) TransferAsset(c *gin.Context) {
+	var req dto.TransferAssetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.TransferAsset(c.Param("id"), req, userID)
+	if err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetTransfers godoc
+// @Summary Get my asset transfers
+// @Description List the asset transfers you offered (OUTGOING) or received (INCOMING), newest first
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.AssetTransferResponse
+// @Failure 500 {object} map[string]string
+// @Router /assets/transfers [get]
+func (ctrl *AssetController) GetTransfers(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	transfers, err := ctrl.Service.GetTransfers(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, transfers)
+}
+
+// AcceptTransfer godoc
+// @Summary Accept an asset transfer
+// @Description Become the owner of an asset offered to you. Its found reports and lost history move with it (recipient only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} dto.AssetTransferResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/transfers/{id}/accept [put]
+func (ctrl *AssetController) AcceptTransfer(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.AcceptTransfer(c.Param("id"), userID)
+	if err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DeclineTransfer godoc
+// @Summary Decline an asset transfer
+// @Description Turn down an asset offered to you; the sender stays the owner (recipient only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} dto.AssetTransferResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/transfers/{id}/decline [put]
+func (ctrl *AssetController) DeclineTransfer(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.DeclineTransfer(c.Param("id"), userID)
+	if err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// CancelTransfer godoc
+// @Summary Cancel an asset transfer
+// @Description Withdraw a transfer the recipient has not answered yet (sender only)
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} dto.AssetTransferResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/transfers/{id} [delete]
+func (ctrl *AssetController) CancelTransfer(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.CancelTransfer(c.Param("id"), userID)
+	if err != nil {
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// assetError maps asset errors: a missing asset, report or transfer is 404, refusals are 403,
+// the rest is a bad request
+func assetError(c *gin.Context, err error) {
+	msg := err.Error()
+	switch {
+	case strings.HasSuffix(msg, "not found"):
+		c.JSON(http.StatusNotFound, gin.H{"error": msg})
+	case strings.HasPrefix(msg, "only "), strings.HasPrefix(msg, "your reports"):
+		c.JSON(http.StatusForbidden, gin.H{"error": msg})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": msg})
+	}
+}
+
 // GetLostAssets godoc
 // @Summary Get all lost assets
 // @Description Get a list of assets reported as lost
 // @Tags assets
 // @Accept json
 // @Produce json
-// @Success 200 {object} []dto.AssetResponse
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from next_cursor of the previous page"
+// @Param sort query string false "created_at or -created_at (default)"
+// @Success 200 {object} dto.Page{data=[]dto.AssetResponse}
+// @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /assets/lost [get]
 func (ctrl *AssetController) GetLostAssets(c *gin.Context) {
-	assets, err := ctrl.Service.GetLostAssets()
+	page, err := pagination.FromQuery(c, map[string]string{"created_at": "created_at"}, "-created_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	assets, err := ctrl.Service.GetLostAssets(page)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -190,12 +534,22 @@ func (ctrl *AssetController) GetLostAssets(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} []dto.AssetResponse
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from next_cursor of the previous page"
+// @Param sort query string false "created_at or -created_at (default)"
+// @Success 200 {object} dto.Page{data=[]dto.AssetResponse}
+// @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /assets/my [get]
 func (ctrl *AssetController) GetUserAssets(c *gin.Context) {
+	page, err := pagination.FromQuery(c, map[string]string{"created_at": "created_at"}, "-created_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	userID := middleware.GetUserID(c)
-	assets, err := ctrl.Service.GetUserAssets(userID)
+	assets, err := ctrl.Service.GetUserAssets(userID, page)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
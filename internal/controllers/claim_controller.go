@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/pagination"
+	"campus-lost-and-found/internal/services"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ClaimController struct {
+	Service *services.ClaimService
+}
+
+func NewClaimController(service *services.ClaimService) *ClaimController {
+	return &ClaimController{Service: service}
+}
+
+// GetMyClaims godoc
+// @Summary Get my claims
+// @Description Get the authenticated user's claims with their status, rejection reason and appeal outcome
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from next_cursor of the previous page"
+// @Param sort query string false "created_at or -created_at (default)"
+// @Success 200 {object} dto.Page{data=[]dto.MyClaimResponse}
+// @Failure 400 {object} map[string]string
+// @Router /claims/my [get]
+func (ctrl *ClaimController) GetMyClaims(c *gin.Context) {
+	page, err := pagination.FromQuery(c, map[string]string{"created_at": "created_at"}, "-created_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.GetMyClaims(userID, page)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// WithdrawClaim godoc
+// @Summary Withdraw a claim
+// @Description Withdraw a pending, escalated or approved claim (Claimant only). Withdrawing an approved claim before handover reopens the item
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Claim ID"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /claims/{id} [delete]
+func (ctrl *ClaimController) WithdrawClaim(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if err := ctrl.Service.Withdraw(c.Param("id"), userID); err != nil {
+		claimError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Claim withdrawn"})
+}
+
+// AppealClaim godoc
+// @Summary Appeal a rejected claim
+// @Description Escalate a rejected claim to a SECURITY or ADMIN reviewer (Claimant only, once per claim)
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Claim ID"
+// @Param request body dto.AppealClaimRequest true "Appeal"
+// @Success 200 {object} dto.MyClaimResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /claims/{id}/appeal [post]
+func (ctrl *ClaimController) AppealClaim(c *gin.Context) {
+	var req dto.AppealClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.Appeal(c.Param("id"), req, userID)
+	if err != nil {
+		claimError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetEscalatedClaims godoc
+// @Summary Get appealed claims
+// @Description Get the queue of escalated claims with the claimant's answers, oldest appeal first (Security, Admin)
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []models.Claim
+// @Failure 403 {object} map[string]string
+// @Router /claims/escalated [get]
+func (ctrl *ClaimController) GetEscalatedClaims(c *gin.Context) {
+	res, err := ctrl.Service.GetEscalated()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ReviewClaim godoc
+// @Summary Review an appealed claim
+// @Description Record the final decision on an escalated claim (Security, Admin). APPROVED approves the claim and issues the pickup code
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Claim ID"
+// @Param request body dto.ReviewClaimRequest true "Decision"
+// @Success 200 {object} dto.MyClaimResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /claims/{id}/review [put]
+func (ctrl *ClaimController) ReviewClaim(c *gin.Context) {
+	var req dto.ReviewClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.Review(c.Param("id"), req, userID)
+	if err != nil {
+		claimError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+func claimError(c *gin.Context, err error) {
+	switch {
+	case err.Error() == "claim not found":
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "only the claimant"):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/services"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ClaimMessageController struct {
+	Service *services.ClaimMessageService
+}
+
+func NewClaimMessageController(service *services.ClaimMessageService) *ClaimMessageController {
+	return &ClaimMessageController{Service: service}
+}
+
+// PostMessage godoc
+// @Summary Send a message on a claim
+// @Description Send a message in the claim thread as JSON, or as multipart/form-data with an optional image "attachment". The other side is notified (Finder, Claimant or Security)
+// @Tags claims
+// @Accept json,mpfd
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Claim ID"
+// @Param request body dto.ClaimMessageRequest false "Message (JSON)"
+// @Param body formData string false "Message text (multipart)"
+// @Param attachment formData file false "Image attachment (multipart)"
+// @Success 201 {object} dto.ClaimMessageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /claims/{id}/messages [post]
+func (ctrl *ClaimMessageController) PostMessage(c *gin.Context) {
+	var req dto.ClaimMessageRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var file multipart.File
+	var header *multipart.FileHeader
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		if f, h, err := c.Request.FormFile("attachment"); err == nil {
+			defer f.Close()
+			file, header = f, h
+		}
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.PostMessage(c.Param("id"), req, file, header, userID, c.GetString("role"))
+	if err != nil {
+		claimMessageError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// GetMessages godoc
+// @Summary Get a claim's messages
+// @Description Get the claim thread, oldest first (Finder, Claimant or Security)
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Claim ID"
+// @Success 200 {object} []dto.ClaimMessageResponse
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /claims/{id}/messages [get]
+func (ctrl *ClaimMessageController) GetMessages(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.GetMessages(c.Param("id"), userID, c.GetString("role"))
+	if err != nil {
+		claimMessageError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func claimMessageError(c *gin.Context, err error) {
+	switch {
+	case err.Error() == "claim not found":
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "only "):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
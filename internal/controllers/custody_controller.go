@@ -0,0 +1,162 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CustodyController struct {
+	Service *services.CustodyService
+}
+
+func NewCustodyController(service *services.CustodyService) *CustodyController {
+	return &CustodyController{Service: service}
+}
+
+// CheckIn godoc
+// @Summary Check in an item at a security desk
+// @Description Record a found item handed to security, with the desk and storage bin it is kept in (Security only)
+// @Tags custody
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CheckInRequest true "Check In Request"
+// @Success 201 {object} dto.CustodyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /custody/check-in [post]
+func (ctrl *CustodyController) CheckIn(c *gin.Context) {
+	var req dto.CheckInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.CheckIn(req, userID)
+	if err != nil {
+		if err.Error() == "item not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// TransferCustody godoc
+// @Summary Transfer an item between desks
+// @Description Move an item in custody to another desk, or to another storage bin at the same desk (Security only)
+// @Tags custody
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID"
+// @Param request body dto.TransferCustodyRequest true "Transfer Request"
+// @Success 200 {object} dto.CustodyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /custody/items/{id}/transfer [post]
+func (ctrl *CustodyController) TransferCustody(c *gin.Context) {
+	var req dto.TransferCustodyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.Transfer(c.Param("id"), req, userID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// CheckOut godoc
+// @Summary Check out an item to its owner
+// @Description Hand an item in custody to the approved claimant after checking their NIM/NIP in person (Security only)
+// @Tags custody
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID"
+// @Param request body dto.CheckOutRequest true "Check Out Request"
+// @Success 200 {object} dto.CustodyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /custody/items/{id}/check-out [post]
+func (ctrl *CustodyController) CheckOut(c *gin.Context) {
+	var req dto.CheckOutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.CheckOut(c.Param("id"), req, userID)
+	if err != nil {
+		if err.Error() == "identity number does not match the approved claimant" {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetCustody godoc
+// @Summary Get an item's custody chain
+// @Description Get where an item is kept and every signed custody event, with signature verification (Security only)
+// @Tags custody
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID"
+// @Success 200 {object} dto.CustodyResponse
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /custody/items/{id} [get]
+func (ctrl *CustodyController) GetCustody(c *gin.Context) {
+	res, err := ctrl.Service.GetCustody(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetDeskInventory godoc
+// @Summary Get a desk's inventory
+// @Description Get the items a security desk currently holds, ordered by storage bin (Security only)
+// @Tags custody
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Desk (Campus Location) ID"
+// @Success 200 {object} []dto.CustodyResponse
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /custody/desks/{id}/inventory [get]
+func (ctrl *CustodyController) GetDeskInventory(c *gin.Context) {
+	res, err := ctrl.Service.GetDeskInventory(c.Param("id"))
+	if err != nil {
+		if err.Error() == "desk not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DisposalController struct {
+	Service *services.RetentionService
+}
+
+func NewDisposalController(service *services.RetentionService) *DisposalController {
+	return &DisposalController{Service: service}
+}
+
+// GetDisposalQueue godoc
+// @Summary Get the disposal queue
+// @Description Get expired found items whose retention period ended without a claim, with the desk holding them (SECURITY, ADMIN)
+// @Tags disposals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.DisposalQueueResponse
+// @Failure 403 {object} map[string]string
+// @Router /disposals [get]
+func (ctrl *DisposalController) GetDisposalQueue(c *gin.Context) {
+	res, err := ctrl.Service.GetDisposalQueue()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DisposeItem godoc
+// @Summary Record an item's disposition
+// @Description Record that an expired item was donated, disposed of or transferred. The item is archived and leaves security custody (SECURITY, ADMIN)
+// @Tags disposals
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.DisposeItemRequest true "Disposition"
+// @Success 201 {object} dto.DisposalResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /disposals [post]
+func (ctrl *DisposalController) DisposeItem(c *gin.Context) {
+	var req dto.DisposeItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.Dispose(req, userID)
+	if err != nil {
+		if err.Error() == "item not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusCreated, res)
+}
@@ -50,12 +50,17 @@ func (ctrl *EnumerationController) GetLocations(c *gin.Context) {
 }
 
 type CreateCategoryRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name          string `json:"name" binding:"required"`
+	RetentionDays int    `json:"retention_days" binding:"omitempty,min=1" example:"90"` // Defaults to ITEM_RETENTION_DAYS
+}
+
+type UpdateCategoryRetentionRequest struct {
+	RetentionDays int `json:"retention_days" binding:"required,min=1" example:"30"`
 }
 
 // CreateCategory godoc
 // @Summary Create item category
-// @Description Create a new item category
+// @Description Create a new item category (ADMIN only)
 // @Tags enumerations
 // @Accept json
 // @Produce json
@@ -63,6 +68,8 @@ type CreateCategoryRequest struct {
 // @Param request body CreateCategoryRequest true "Create Category Request"
 // @Success 200 {object} models.ItemCategory
 // @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Router /enumerations/item-categories [post]
 func (ctrl *EnumerationController) CreateCategory(c *gin.Context) {
 	var req CreateCategoryRequest
@@ -71,7 +78,7 @@ func (ctrl *EnumerationController) CreateCategory(c *gin.Context) {
 		return
 	}
 
-	category, err := ctrl.Repo.CreateCategory(req.Name)
+	category, err := ctrl.Repo.CreateCategory(req.Name, req.RetentionDays)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -80,15 +87,47 @@ func (ctrl *EnumerationController) CreateCategory(c *gin.Context) {
 	c.JSON(http.StatusOK, category)
 }
 
+// UpdateCategoryRetention godoc
+// @Summary Set category retention
+// @Description Set how many days unclaimed found items in a category are kept before they expire (ADMIN only)
+// @Tags enumerations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Category ID"
+// @Param request body UpdateCategoryRetentionRequest true "Retention"
+// @Success 200 {object} models.ItemCategory
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /enumerations/item-categories/{id}/retention [put]
+func (ctrl *EnumerationController) UpdateCategoryRetention(c *gin.Context) {
+	var req UpdateCategoryRetentionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	category, err := ctrl.Repo.UpdateCategoryRetention(c.Param("id"), req.RetentionDays)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "category not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, category)
+}
+
 type CreateLocationRequest struct {
 	Name      string  `json:"name" binding:"required"`
 	Latitude  float64 `json:"latitude" binding:"required"`
 	Longitude float64 `json:"longitude" binding:"required"`
+	// Staffed security post, suggested to finders who scan an asset QR
+	IsSecurityDesk bool `json:"is_security_desk"`
 }
 
 // CreateLocation godoc
 // @Summary Create campus location
-// @Description Create a new campus location
+// @Description Create a new campus location, optionally marked as a security desk (ADMIN only)
 // @Tags enumerations
 // @Accept json
 // @Produce json
@@ -96,6 +135,8 @@ type CreateLocationRequest struct {
 // @Param request body CreateLocationRequest true "Create Location Request"
 // @Success 200 {object} models.CampusLocation
 // @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Router /enumerations/campus-locations [post]
 func (ctrl *EnumerationController) CreateLocation(c *gin.Context) {
 	var req CreateLocationRequest
@@ -104,7 +145,7 @@ func (ctrl *EnumerationController) CreateLocation(c *gin.Context) {
 		return
 	}
 
-	location, err := ctrl.Repo.CreateLocation(req.Name, req.Latitude, req.Longitude)
+	location, err := ctrl.Repo.CreateLocation(req.Name, req.Latitude, req.Longitude, req.IsSecurityDesk)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -3,8 +3,13 @@ package controllers
 import (
 	"campus-lost-and-found/internal/dto"
 	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/pagination"
+	"campus-lost-and-found/internal/repository"
 	"campus-lost-and-found/internal/services"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -80,9 +85,13 @@ func (ctrl *ItemController) ReportLostItem(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param status query string false "Filter by status (e.g., OPEN, CLAIMED)"
+// @Param status query string false "Filter by status (OPEN, CLAIMED, RESOLVED, EXPIRED or ARCHIVED)"
 // @Param type query string false "Filter by type (LOST, FOUND)"
-// @Success 200 {object} []dto.ItemResponse
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from next_cursor of the previous page"
+// @Param sort query string false "created_at or -created_at (default)"
+// @Success 200 {object} dto.Page{data=[]dto.ItemResponse}
+// @Failure 400 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /items [get]
 func (ctrl *ItemController) GetAllItems(c *gin.Context) {
@@ -94,12 +103,18 @@ func (ctrl *ItemController) GetAllItems(c *gin.Context) {
 		c.JSON(400, gin.H{"error": "invalid type: must be FOUND or LOST"})
 		return
 	}
-	if status != "" && status != "OPEN" && status != "CLAIMED" && status != "RESOLVED" {
-		c.JSON(400, gin.H{"error": "invalid status: must be OPEN, CLAIMED, or RESOLVED"})
+	if status != "" && status != "OPEN" && status != "CLAIMED" && status != "RESOLVED" && status != "EXPIRED" && status != "ARCHIVED" {
+		c.JSON(400, gin.H{"error": "invalid status: must be OPEN, CLAIMED, RESOLVED, EXPIRED, or ARCHIVED"})
+		return
+	}
+
+	page, err := pagination.FromQuery(c, map[string]string{"created_at": "sort_time"}, "-created_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	items, err := ctrl.Service.GetAllItems(status, itemType)
+	items, err := ctrl.Service.GetAllItems(status, itemType, page)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -110,7 +125,7 @@ func (ctrl *ItemController) GetAllItems(c *gin.Context) {
 
 // SubmitClaim godoc
 // @Summary Submit a claim for an item
-// @Description Claim a found item
+// @Description Claim a found item. One pending claim per user and item; finders cannot claim their own item
 // @Tags items
 // @Accept json
 // @Produce json
@@ -119,6 +134,7 @@ func (ctrl *ItemController) GetAllItems(c *gin.Context) {
 // @Param request body dto.CreateClaimRequest true "Create Claim Request"
 // @Success 200 {object} dto.ClaimResponse
 // @Failure 400 {object} map[string]string
+// @Failure 429 {object} map[string]string
 // @Router /items/{id}/claim [post]
 func (ctrl *ItemController) SubmitClaim(c *gin.Context) {
 	id := c.Param("id")
@@ -162,7 +178,7 @@ func (ctrl *ItemController) GetClaims(c *gin.Context) {
 
 // DecideClaim godoc
 // @Summary Approve or Reject a claim
-// @Description Decide on a claim (Finder only)
+// @Description Decide on a claim (Finder only). Approving rejects every other pending claim on the item
 // @Tags claims
 // @Accept json
 // @Produce json
@@ -181,7 +197,7 @@ func (ctrl *ItemController) DecideClaim(c *gin.Context) {
 	}
 
 	userID := middleware.GetUserID(c)
-	err := ctrl.Service.DecideClaim(id, req.Status, userID)
+	err := ctrl.Service.DecideClaim(id, req.Status, req.Reason, userID)
 	if err != nil {
 		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		return
@@ -288,7 +304,7 @@ func (ctrl *ItemController) UpdateItem(c *gin.Context) {
 
 // UpdateItemStatus godoc
 // @Summary Update item status
-// @Description Mark item as RESOLVED or CLAIMED (Finder or Owner only)
+// @Description Move an item to another status (Finder, Owner or Security). Only transitions allowed by the item lifecycle are accepted, and each is recorded in the item's history. CLAIMED is only reached by approving a claim
 // @Tags items
 // @Accept json
 // @Produce json
@@ -296,6 +312,7 @@ func (ctrl *ItemController) UpdateItem(c *gin.Context) {
 // @Param id path string true "Item ID"
 // @Param request body dto.UpdateItemStatusRequest true "Update Item Status Request"
 // @Success 200 {object} dto.ItemResponse
+// @Failure 400 {object} map[string]string
 // @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Router /items/{id}/status [put]
@@ -308,19 +325,49 @@ func (ctrl *ItemController) UpdateItemStatus(c *gin.Context) {
 	}
 
 	userID := middleware.GetUserID(c)
-	res, err := ctrl.Service.UpdateItemStatus(id, req.Status, userID)
+	res, err := ctrl.Service.UpdateItemStatus(id, req, userID, c.GetString("role"))
 	if err != nil {
-		if err.Error() == "unauthorized" {
-			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		}
+		itemStatusError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GetItemHistory godoc
+// @Summary Get item status history
+// @Description Get every status change of an item with who made it and why, oldest first (Finder, Owner or Security)
+// @Tags items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID"
+// @Success 200 {object} []dto.ItemStatusHistoryResponse
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /items/{id}/history [get]
+func (ctrl *ItemController) GetItemHistory(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.GetStatusHistory(c.Param("id"), userID, c.GetString("role"))
+	if err != nil {
+		itemStatusError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, res)
 }
 
+func itemStatusError(c *gin.Context, err error) {
+	switch {
+	case err.Error() == "item not found":
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case err.Error() == "unauthorized" || strings.HasPrefix(err.Error(), "only "):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
+
 // GetUserItems godoc
 // @Summary Get my items
 // @Description Get items reported by the authenticated user
@@ -328,14 +375,98 @@ func (ctrl *ItemController) UpdateItemStatus(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} []dto.ItemResponse
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from next_cursor of the previous page"
+// @Param sort query string false "created_at or -created_at (default)"
+// @Success 200 {object} dto.Page{data=[]dto.ItemResponse}
+// @Failure 400 {object} map[string]string
 // @Router /items/my [get]
 func (ctrl *ItemController) GetUserItems(c *gin.Context) {
+	page, err := pagination.FromQuery(c, map[string]string{"created_at": "created_at"}, "-created_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	userID := middleware.GetUserID(c)
-	items, err := ctrl.Service.GetUserItems(userID)
+	items, err := ctrl.Service.GetUserItems(userID, page)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, items)
 }
+
+// Search godoc
+// @Summary Search items and lost assets
+// @Description Full-text search (English and Indonesian stemming) over item title, description, location description and lost asset description, ranked by relevance. Only OPEN items and lost assets unless another status is asked for
+// @Tags items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search text"
+// @Param status query string false "Filter by status (OPEN, CLAIMED, RESOLVED, EXPIRED or ARCHIVED, default OPEN)"
+// @Param category_id query string false "Filter by category ID"
+// @Param location_id query string false "Filter by campus location ID"
+// @Param date_from query string false "Only reports on or after this date (YYYY-MM-DD)"
+// @Param date_to query string false "Only reports on or before this date (YYYY-MM-DD)"
+// @Param urgency query string false "Filter by urgency (NORMAL, HIGH, CRITICAL), lost items only"
+// @Param limit query int false "Max results (default 20, max 100)"
+// @Success 200 {object} []dto.SearchResultResponse
+// @Failure 400 {object} map[string]string
+// @Router /search [get]
+func (ctrl *ItemController) Search(c *gin.Context) {
+	filter := repository.SearchFilter{
+		Query:      c.Query("q"),
+		Status:     c.DefaultQuery("status", "OPEN"),
+		CategoryID: c.Query("category_id"),
+		LocationID: c.Query("location_id"),
+		Urgency:    c.Query("urgency"),
+	}
+
+	if filter.Query == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "q is required"})
+		return
+	}
+	if filter.Status != "OPEN" && filter.Status != "CLAIMED" && filter.Status != "RESOLVED" && filter.Status != "EXPIRED" && filter.Status != "ARCHIVED" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid status: must be OPEN, CLAIMED, RESOLVED, EXPIRED, or ARCHIVED"})
+		return
+	}
+	if filter.Urgency != "" && filter.Urgency != "NORMAL" && filter.Urgency != "HIGH" && filter.Urgency != "CRITICAL" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid urgency: must be NORMAL, HIGH, or CRITICAL"})
+		return
+	}
+	if dateFrom := c.Query("date_from"); dateFrom != "" {
+		t, err := time.Parse("2006-01-02", dateFrom)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date_from format, use YYYY-MM-DD"})
+			return
+		}
+		filter.DateFrom = &t
+	}
+	if dateTo := c.Query("date_to"); dateTo != "" {
+		t, err := time.Parse("2006-01-02", dateTo)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date_to format, use YYYY-MM-DD"})
+			return
+		}
+		// Inclusive: everything before the start of the next day
+		t = t.AddDate(0, 0, 1)
+		filter.DateTo = &t
+	}
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		filter.Limit = n
+	}
+
+	results, err := ctrl.Service.Search(filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, results)
+}
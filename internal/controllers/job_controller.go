@@ -0,0 +1,61 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/jobs"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JobController struct {
+	Runner *jobs.Runner
+}
+
+func NewJobController(runner *jobs.Runner) *JobController {
+	return &JobController{Runner: runner}
+}
+
+// GetFailedJobs godoc
+// @Summary Get failed background jobs
+// @Description Get jobs that exhausted their retries (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []models.Job
+// @Failure 403 {object} map[string]string
+// @Router /admin/jobs/failed [get]
+func (ctrl *JobController) GetFailedJobs(c *gin.Context) {
+	failed, err := ctrl.Runner.FailedJobs()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, failed)
+}
+
+// RequeueJob godoc
+// @Summary Re-queue a failed job
+// @Description Reset a dead job's attempts and queue it again (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Job ID"
+// @Success 200 {object} models.Job
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /admin/jobs/{id}/requeue [post]
+func (ctrl *JobController) RequeueJob(c *gin.Context) {
+	id := c.Param("id")
+	job, err := ctrl.Runner.Requeue(id)
+	if err != nil {
+		if err.Error() == "job not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
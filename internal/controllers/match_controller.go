@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MatchController struct {
+	Service *services.MatchService
+}
+
+func NewMatchController(service *services.MatchService) *MatchController {
+	return &MatchController{Service: service}
+}
+
+// GetItemMatches godoc
+// @Summary Get match suggestions for an item
+// @Description Get match suggestions for a lost item, lost asset or found item (owner or finder only)
+// @Tags matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item or Asset ID"
+// @Param status query string false "Filter by status (SUGGESTED, ACCEPTED, DISMISSED)"
+// @Success 200 {object} []dto.MatchResponse
+// @Failure 400 {object} map[string]string
+// @Router /items/{id}/matches [get]
+func (ctrl *MatchController) GetItemMatches(c *gin.Context) {
+	id := c.Param("id")
+	status := c.Query("status")
+	if status != "" && status != "SUGGESTED" && status != "ACCEPTED" && status != "DISMISSED" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid status: must be SUGGESTED, ACCEPTED, or DISMISSED"})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	matches, err := ctrl.Service.GetMatches(id, status, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, matches)
+}
+
+// UpdateMatch godoc
+// @Summary Accept or dismiss a match
+// @Description Accept (submits a prefilled claim) or dismiss a match suggestion (lost report owner only)
+// @Tags matches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Match ID"
+// @Param request body dto.UpdateMatchRequest true "Update Match Request"
+// @Success 200 {object} dto.MatchResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /matches/{id} [put]
+func (ctrl *MatchController) UpdateMatch(c *gin.Context) {
+	id := c.Param("id")
+	var req dto.UpdateMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.UpdateMatch(id, req, userID)
+	if err != nil {
+		switch err.Error() {
+		case "match not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "unauthorized":
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
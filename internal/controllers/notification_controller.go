@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/pagination"
 	"campus-lost-and-found/internal/services"
 	"net/http"
 
@@ -23,11 +24,21 @@ func NewNotificationController(service *services.NotificationService) *Notificat
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} []models.Notification
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from next_cursor of the previous page"
+// @Param sort query string false "created_at or -created_at (default)"
+// @Success 200 {object} dto.Page{data=[]models.Notification}
+// @Failure 400 {object} map[string]string
 // @Router /notifications [get]
 func (ctrl *NotificationController) GetNotifications(c *gin.Context) {
+	page, err := pagination.FromQuery(c, map[string]string{"created_at": "created_at"}, "-created_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	userID := middleware.GetUserID(c)
-	notifs, err := ctrl.Service.GetUserNotifications(userID.String())
+	notifs, err := ctrl.Service.GetUserNotifications(userID.String(), page)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
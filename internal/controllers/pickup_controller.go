@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/services"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PickupController struct {
+	Service *services.PickupService
+}
+
+func NewPickupController(service *services.PickupService) *PickupController {
+	return &PickupController{Service: service}
+}
+
+// GetPickupCode godoc
+// @Summary Get the pickup code of an approved claim
+// @Description Get the one-time code (numeric and QR) to show the finder or security when collecting the item (Claimant only)
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Claim ID"
+// @Success 200 {object} dto.PickupCodeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /claims/{id}/pickup-code [get]
+func (ctrl *PickupController) GetPickupCode(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.GetCode(c.Param("id"), userID)
+	if err != nil {
+		pickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// RegeneratePickupCode godoc
+// @Summary Request a new pickup code
+// @Description Replace an expired or locked pickup code; the old code stops working (Claimant only)
+// @Tags claims
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Claim ID"
+// @Success 201 {object} dto.PickupCodeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /claims/{id}/pickup-code [post]
+func (ctrl *PickupController) RegeneratePickupCode(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.Regenerate(c.Param("id"), userID)
+	if err != nil {
+		pickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+// Handover godoc
+// @Summary Confirm handover with a pickup code
+// @Description Enter or scan the claimant's pickup code. The item is resolved and the claim completed. Items kept at a security desk are checked out of custody (Finder or Security)
+// @Tags items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Item ID"
+// @Param request body dto.HandoverRequest true "Handover Request"
+// @Success 200 {object} dto.HandoverResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /items/{id}/handover [post]
+func (ctrl *PickupController) Handover(c *gin.Context) {
+	var req dto.HandoverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	res, err := ctrl.Service.Handover(c.Param("id"), req, userID, c.GetString("role"))
+	if err != nil {
+		pickupError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func pickupError(c *gin.Context, err error) {
+	switch {
+	case strings.HasSuffix(err.Error(), "not found"):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case strings.HasPrefix(err.Error(), "only "), strings.HasSuffix(err.Error(), "security must confirm the handover"):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	}
+}
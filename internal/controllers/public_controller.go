@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/pagination"
+	"campus-lost-and-found/internal/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PublicController struct {
+	Service *services.PublicService
+}
+
+func NewPublicController(service *services.PublicService) *PublicController {
+	return &PublicController{Service: service}
+}
+
+// GetPublicFeed godoc
+// @Summary Get the public item feed
+// @Description Browse OPEN found and lost items without an account. Personal names, private images and verification details are left out, and contacts are only shown when the reporter enabled show_phone. Rate limited per IP
+// @Tags public
+// @Accept json
+// @Produce json
+// @Param type query string false "Filter by type (LOST or FOUND)"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from next_cursor of the previous page"
+// @Param sort query string false "created_at or -created_at (default)"
+// @Success 200 {object} dto.Page{data=[]dto.PublicItemResponse}
+// @Failure 400 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /public/items [get]
+func (ctrl *PublicController) GetPublicFeed(c *gin.Context) {
+	itemType := c.Query("type")
+	if itemType != "" && itemType != "LOST" && itemType != "FOUND" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid type: must be LOST or FOUND"})
+		return
+	}
+
+	page, err := pagination.FromQuery(c, map[string]string{"created_at": "sort_time"}, "-created_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := ctrl.Service.GetFeed(itemType, page)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetPublicItem godoc
+// @Summary Get a public item
+// @Description Get the redacted view of an item, or of an asset in lost mode, without an account. Rate limited per IP
+// @Tags public
+// @Accept json
+// @Produce json
+// @Param id path string true "Item or Asset ID"
+// @Success 200 {object} dto.PublicItemResponse
+// @Failure 404 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /public/items/{id} [get]
+func (ctrl *PublicController) GetPublicItem(c *gin.Context) {
+	res, err := ctrl.Service.GetItem(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GetPublicThumbnail godoc
+// @Summary Get an item's public preview
+// @Description Get a small blurred JPEG preview of an item's uploaded image. Rate limited per IP
+// @Tags public
+// @Produce jpeg
+// @Param id path string true "Item ID"
+// @Success 200 {file} binary
+// @Failure 404 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /public/items/{id}/thumbnail [get]
+func (ctrl *PublicController) GetPublicThumbnail(c *gin.Context) {
+	path, err := ctrl.Service.GetThumbnail(c.Param("id"))
+	if err != nil {
+		if err.Error() == "item not found" || err.Error() == "item has no preview" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.File(path)
+}
@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScanController struct {
+	Service *services.ScanService
+}
+
+func NewScanController(service *services.ScanService) *ScanController {
+	return &ScanController{Service: service}
+}
+
+// GetScan godoc
+// @Summary Scan an asset QR
+// @Description Landing page of an asset's QR sticker, no account needed. The QR code links here with a signed token t; a missing or forged token is a 404 and a token from a code the owner rotated is a 410. Returns the category, a message for the finder, the security desks (sorted by distance, with the nearest picked out, when lat and lng are given) and a challenge to answer when reporting the asset found. Rate limited per IP
+// @Tags scan
+// @Accept json
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Param t query string true "Token from the QR code"
+// @Param lat query number false "Scanner latitude"
+// @Param lng query number false "Scanner longitude"
+// @Success 200 {object} dto.ScanResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 410 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /scan/{id} [get]
+func (ctrl *ScanController) GetScan(c *gin.Context) {
+	var lat, lng *float64
+	latParam, lngParam := c.Query("lat"), c.Query("lng")
+	if latParam != "" || lngParam != "" {
+		la, errLat := strconv.ParseFloat(latParam, 64)
+		lo, errLng := strconv.ParseFloat(lngParam, 64)
+		if errLat != nil || errLng != nil || la < -90 || la > 90 || lo < -180 || lo > 180 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "lat and lng must be given together as valid coordinates"})
+			return
+		}
+		lat, lng = &la, &lo
+	}
+
+	res, err := ctrl.Service.GetScan(c.Param("id"), c.Query("t"), lat, lng)
+	if err != nil {
+		switch err.Error() {
+		case "asset not found":
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case "this QR code has been revoked by the owner":
+			c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// ReportScanFound godoc
+// @Summary Report a scanned asset found
+// @Description Tell the owner where their asset was found, without an account. The challenge token and question come from GET /scan/{id}. Guests may leave a contact_handle; a signed-in finder is recorded on the report instead. Rate limited per IP and per asset
+// @Tags scan
+// @Accept json
+// @Produce json
+// @Param id path string true "Asset ID"
+// @Param request body dto.ScanReportFoundRequest true "Scan Report Found Request"
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 410 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Router /scan/{id}/report-found [post]
+func (ctrl *ScanController) ReportScanFound(c *gin.Context) {
+	var req dto.ScanReportFoundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := ctrl.Service.ReportFound(c.Param("id"), req, middleware.OptionalUserID(c), c.ClientIP()); err != nil {
+		if err.Error() == "this QR code has been revoked by the owner" {
+			c.JSON(http.StatusGone, gin.H{"error": err.Error()})
+			return
+		}
+		assetError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Thank you! The owner has been notified"})
+}
@@ -3,6 +3,8 @@ package controllers
 import (
 	"campus-lost-and-found/internal/dto"
 	"campus-lost-and-found/internal/middleware"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
 	"campus-lost-and-found/internal/services"
 	"net/http"
 
@@ -19,16 +21,27 @@ func NewUserController(service *services.UserService) *UserController {
 
 // GetAllUsers godoc
 // @Summary Get all users
-// @Description Get a list of all users
+// @Description Get a list of all users (ADMIN only)
 // @Tags users
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {object} []dto.UserDetailResponse
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from next_cursor of the previous page"
+// @Param sort query string false "created_at, name; prefix with - for descending (default -created_at)"
+// @Success 200 {object} dto.Page{data=[]dto.UserDetailResponse}
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /users [get]
 func (ctrl *UserController) GetAllUsers(c *gin.Context) {
-	users, err := ctrl.Service.GetAllUsers()
+	page, err := pagination.FromQuery(c, map[string]string{"created_at": "created_at", "name": "name"}, "-created_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	users, err := ctrl.Service.GetAllUsers(page)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -38,7 +51,7 @@ func (ctrl *UserController) GetAllUsers(c *gin.Context) {
 
 // GetUser godoc
 // @Summary Get user by ID
-// @Description Get a specific user by their ID
+// @Description Get a specific user by their ID. Phone and identity number are only returned for your own profile or to admins
 // @Tags users
 // @Accept json
 // @Produce json
@@ -50,7 +63,8 @@ func (ctrl *UserController) GetAllUsers(c *gin.Context) {
 // @Router /users/{id} [get]
 func (ctrl *UserController) GetUser(c *gin.Context) {
 	userID := c.Param("id")
-	user, err := ctrl.Service.GetUserByID(userID)
+	full := userID == middleware.GetUserID(c).String() || middleware.Allowed(c.GetString("role"), middleware.PermListUsers)
+	user, err := ctrl.Service.GetUserByID(userID, full)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
@@ -86,3 +100,92 @@ func (ctrl *UserController) UpdateUser(c *gin.Context) {
 
 	c.JSON(http.StatusOK, user)
 }
+
+// CreateUser godoc
+// @Summary Create a user with any role
+// @Description Create an account with any role, including ADMIN and SECURITY, recorded in the role audit trail (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.CreateUserRequest true "Create User Request"
+// @Success 201 {object} dto.UserDetailResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /admin/users [post]
+func (ctrl *UserController) CreateUser(c *gin.Context) {
+	var req dto.CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	adminID := middleware.GetUserID(c)
+	user, err := ctrl.Service.CreateUser(req, &adminID, models.RoleChangeSourceAdmin)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, user)
+}
+
+// ChangeRole godoc
+// @Summary Change a user's role
+// @Description Promote or demote a user, recorded in the role audit trail (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body dto.ChangeRoleRequest true "Change Role Request"
+// @Success 200 {object} dto.UserDetailResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /admin/users/{id}/role [put]
+func (ctrl *UserController) ChangeRole(c *gin.Context) {
+	var req dto.ChangeRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	adminID := middleware.GetUserID(c)
+	user, err := ctrl.Service.ChangeRole(c.Param("id"), req, &adminID, models.RoleChangeSourceAdmin)
+	if err != nil {
+		if err.Error() == "user not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, user)
+}
+
+// GetRoleChanges godoc
+// @Summary Get a user's role audit trail
+// @Description Get every role given to a user by an admin or the bootstrap CLI, newest first (Admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} []models.RoleChange
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /admin/users/{id}/role-changes [get]
+func (ctrl *UserController) GetRoleChanges(c *gin.Context) {
+	changes, err := ctrl.Service.GetRoleChanges(c.Param("id"))
+	if err != nil {
+		if err.Error() == "invalid user ID format" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	c.JSON(http.StatusOK, changes)
+}
@@ -13,6 +13,13 @@ type CreateAssetRequest struct {
 	LostMode        bool      `json:"lost_mode"`
 }
 
+// UpdateAssetRequest edits an asset; empty fields are left unchanged
+type UpdateAssetRequest struct {
+	CategoryID      *uuid.UUID `json:"category_id" example:"e9464495-bfe5-4ed0-8ea4-a2d69afa0b39"`
+	Description     string     `json:"description" example:"Silver ThinkPad X1 with a cracked corner"`
+	PrivateImageURL string     `json:"private_image_url" example:"http://example.com/thinkpad.jpg"`
+}
+
 type AssetResponse struct {
 	ID              uuid.UUID `json:"id"`
 	OwnerID         uuid.UUID `json:"owner_id"`
@@ -21,16 +28,50 @@ type AssetResponse struct {
 	Description     string    `json:"description"`
 	PrivateImageURL string    `json:"private_image_url,omitempty"` // Only for owner
 	LostMode        bool      `json:"lost_mode"`
-	QRCodeURL       string    `json:"qr_code_url"`
+	QRCodeURL       string    `json:"qr_code_url,omitempty"` // Only for owner, the image encodes the signed scan link
+	ScanURL         string    `json:"scan_url,omitempty"`    // Only for owner, the link in the QR code
 	CreatedAt       time.Time `json:"created_at"`
+
+	// Current lost-mode episode, only set while the asset is lost
+	LostSince        *time.Time `json:"lost_since,omitempty"`
+	LastSeenLocation string     `json:"last_seen_location,omitempty"`
 }
 
 type UpdateLostModeRequest struct {
-	LostMode bool `json:"lost_mode"`
+	LostMode            bool       `json:"lost_mode"`
+	LastSeenLocationID  *uuid.UUID `json:"last_seen_location_id" example:"e9464495-bfe5-4ed0-8ea4-a2d69afa0b39"` // Optional
+	LastSeenDescription string     `json:"last_seen_description" example:"Left it on a desk in the library"`     // Optional
+	LostSince           string     `json:"lost_since" example:"2023-10-26"`                                      // Optional, YYYY-MM-DD or RFC3339, defaults to now
 }
 
 type ReportFoundRequest struct {
-	LocationID uuid.UUID `json:"location_id" binding:"required"`
-	Note       string    `json:"note"`
-	ImageURL   string    `json:"image_url"`
+	LocationID    uuid.UUID `json:"location_id" binding:"required"`
+	Note          string    `json:"note"`
+	ImageURL      string    `json:"image_url"`
+	ContactHandle string    `json:"contact_handle" binding:"max=100" example:"@finder_on_telegram"` // Guests only, ignored when signed in
+}
+
+type FoundEventReplyRequest struct {
+	Message string `json:"message" binding:"required,max=1000" example:"Thank you! Could you leave it at the FTI security desk?"`
+}
+
+type TransferAssetRequest struct {
+	RecipientEmail string `json:"recipient_email" binding:"required,email" example:"budi@student.univ.ac.id"`
+	Note           string `json:"note" binding:"max=500" example:"Sold to you, charger included"`
+}
+
+type AssetTransferResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	AssetID          uuid.UUID  `json:"asset_id"`
+	AssetDescription string     `json:"asset_description"`
+	CategoryName     string     `json:"category_name"`
+	FromUserID       uuid.UUID  `json:"from_user_id"`
+	FromUserName     string     `json:"from_user_name"`
+	ToUserID         uuid.UUID  `json:"to_user_id"`
+	ToUserName       string     `json:"to_user_name"`
+	Direction        string     `json:"direction" example:"INCOMING"` // INCOMING or OUTGOING, seen from the caller
+	Status           string     `json:"status" example:"PENDING"`     // PENDING, ACCEPTED, DECLINED or CANCELLED
+	Note             string     `json:"note"`
+	CreatedAt        time.Time  `json:"created_at"`
+	RespondedAt      *time.Time `json:"responded_at,omitempty"`
 }
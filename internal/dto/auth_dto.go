@@ -8,8 +8,8 @@ type RegisterRequest struct {
 	Password       string `json:"password" binding:"required,min=6" example:"password123"`
 	Phone          string `json:"phone" binding:"required" example:"08123456789"`
 	IdentityNumber string `json:"identity_number" binding:"required" example:"21523001"`
-	Role           string `json:"role" binding:"oneof=PUBLIK MAHASISWA STAFF_DOSEN ADMIN SECURITY" example:"MAHASISWA"`
-	Faculty        string `json:"faculty" example:"Fakultas Teknologi Industri"` // Optional, empty for Staff/Dosen
+	Role           string `json:"role" binding:"omitempty,oneof=PUBLIK MAHASISWA STAFF_DOSEN" example:"MAHASISWA"` // Optional, auto-assigned from the email domain when empty
+	Faculty        string `json:"faculty" example:"Fakultas Teknologi Industri"`                                   // Optional, empty for Staff/Dosen
 }
 
 type LoginRequest struct {
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AppealClaimRequest struct {
+	Reason string `json:"reason" binding:"required" example:"The scratch on the back matches the photo I attached"`
+}
+
+type ReviewClaimRequest struct {
+	Status string `json:"status" binding:"required,oneof=APPROVED REJECTED"`
+	Note   string `json:"note" binding:"required" example:"Receipt confirms ownership"` // Final decision, shown to the claimant
+}
+
+// MyClaimResponse is a claim as its claimant sees it in their history
+type MyClaimResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	ItemID       uuid.UUID  `json:"item_id"`
+	ItemTitle    string     `json:"item_title"`
+	ItemStatus   string     `json:"item_status"`
+	Status       string     `json:"status"` // PENDING, APPROVED, REJECTED, ESCALATED, WITHDRAWN or COMPLETED
+	Reason       string     `json:"reason,omitempty"`
+	AutoApproved bool       `json:"auto_approved"`
+	AppealReason string     `json:"appeal_reason,omitempty"`
+	AppealedAt   *time.Time `json:"appealed_at,omitempty"`
+	ReviewNote   string     `json:"review_note,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClaimMessageRequest is sent as JSON, or as multipart/form-data with an "attachment" image
+type ClaimMessageRequest struct {
+	Body string `json:"body" form:"body" example:"Can you describe the keychain attached to it?"`
+}
+
+type ClaimMessageResponse struct {
+	ID            uuid.UUID `json:"id"`
+	ClaimID       uuid.UUID `json:"claim_id"`
+	SenderID      uuid.UUID `json:"sender_id"`
+	SenderName    string    `json:"sender_name"`
+	SenderRole    string    `json:"sender_role"` // FINDER, CLAIMANT or SECURITY
+	Body          string    `json:"body"`
+	AttachmentURL string    `json:"attachment_url,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
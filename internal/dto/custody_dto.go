@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CheckInRequest struct {
+	ItemID     uuid.UUID `json:"item_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DeskID     uuid.UUID `json:"desk_id" binding:"required" example:"90880496-c0de-4af4-bb06-30e1e0ec9a53"` // Campus location of the security desk
+	StorageBin string    `json:"storage_bin" binding:"required" example:"Shelf B-2"`
+	Note       string    `json:"note" example:"Handed over by the finder, screen cracked"`
+}
+
+type TransferCustodyRequest struct {
+	DeskID     uuid.UUID `json:"desk_id" binding:"required" example:"90880496-c0de-4af4-bb06-30e1e0ec9a53"` // Same desk to only move bins
+	StorageBin string    `json:"storage_bin" binding:"required" example:"Locker 4"`
+	Note       string    `json:"note" example:"Moved to the main desk for weekend storage"`
+}
+
+type CheckOutRequest struct {
+	IdentityNumber string `json:"identity_number" binding:"required" example:"21523120"` // Checked against the approved claimant's NIM/NIP
+	Note           string `json:"note" example:"KTM checked at the desk"`
+}
+
+type CustodyEventResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	Type          string     `json:"type"`
+	DeskID        uuid.UUID  `json:"desk_id"`
+	DeskName      string     `json:"desk_name"`
+	FromDeskID    *uuid.UUID `json:"from_desk_id,omitempty"`
+	FromDeskName  string     `json:"from_desk_name,omitempty"`
+	StorageBin    string     `json:"storage_bin"`
+	ActorID       uuid.UUID  `json:"actor_id"`
+	ActorName     string     `json:"actor_name"`
+	RecipientID   *uuid.UUID `json:"recipient_id,omitempty"`
+	RecipientName string     `json:"recipient_name,omitempty"`
+	Note          string     `json:"note"`
+	Signature     string     `json:"signature"`
+	Valid         bool       `json:"valid"` // Signature matches the event and the chain before it
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type CustodyResponse struct {
+	ID             uuid.UUID              `json:"id"`
+	ItemID         uuid.UUID              `json:"item_id"`
+	ItemTitle      string                 `json:"item_title"`
+	CategoryName   string                 `json:"category_name,omitempty"`
+	DeskID         uuid.UUID              `json:"desk_id"`
+	DeskName       string                 `json:"desk_name"`
+	StorageBin     string                 `json:"storage_bin"`
+	Status         string                 `json:"status"` // IN_CUSTODY or CHECKED_OUT
+	CheckedInAt    time.Time              `json:"checked_in_at"`
+	CheckedOutAt   *time.Time             `json:"checked_out_at,omitempty"`
+	CheckedOutToID *uuid.UUID             `json:"checked_out_to_id,omitempty"`
+	Events         []CustodyEventResponse `json:"events,omitempty"`
+	Verified       *bool                  `json:"verified,omitempty"` // Whole chain is intact, only set with events
+}
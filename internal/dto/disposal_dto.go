@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DisposalQueueResponse is an expired found item waiting for security to dispose of it
+type DisposalQueueResponse struct {
+	ItemID           uuid.UUID  `json:"item_id"`
+	Title            string     `json:"title"`
+	CategoryName     string     `json:"category_name"`
+	RetentionDays    int        `json:"retention_days"`
+	RetentionEndedAt time.Time  `json:"retention_ended_at"`
+	LocationName     string     `json:"location_name,omitempty"` // Where it was found
+	FinderName       string     `json:"finder_name,omitempty"`
+	DeskID           *uuid.UUID `json:"desk_id,omitempty"` // Set when the item is held at a security desk
+	DeskName         string     `json:"desk_name,omitempty"`
+	StorageBin       string     `json:"storage_bin,omitempty"`
+	ReportedAt       time.Time  `json:"reported_at"`
+}
+
+type DisposeItemRequest struct {
+	ItemID      uuid.UUID `json:"item_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Disposition string    `json:"disposition" binding:"required,oneof=DONATED DISPOSED TRANSFERRED"`
+	Recipient   string    `json:"recipient" example:"Rumah Zakat Yogyakarta"` // Charity, agency or waste service
+	Note        string    `json:"note" example:"Donated with the semester clothing batch"`
+}
+
+type DisposalResponse struct {
+	ID           uuid.UUID `json:"id"`
+	ItemID       uuid.UUID `json:"item_id"`
+	ItemTitle    string    `json:"item_title"`
+	ItemStatus   string    `json:"item_status"`
+	Disposition  string    `json:"disposition"`
+	Recipient    string    `json:"recipient,omitempty"`
+	Note         string    `json:"note,omitempty"`
+	RecordedByID uuid.UUID `json:"recorded_by_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
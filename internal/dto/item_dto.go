@@ -17,6 +17,7 @@ type CreateFoundItemRequest struct {
 	COD           bool                  `json:"cod" example:"false"`
 	ShowPhone     bool                  `json:"show_phone" example:"false"`
 	Contacts      []ContactRequest      `json:"contacts" binding:"dive"`
+	AutoApprove   bool                  `json:"auto_approve" example:"false"` // Approve claims whose answers all match exactly
 }
 
 type CreateLostItemRequest struct {
@@ -43,10 +44,23 @@ type UpdateItemRequest struct {
 	OfferReward      bool             `json:"offer_reward" example:"true"`
 	ShowPhone        bool             `json:"show_phone" example:"false"`
 	Contacts         []ContactRequest `json:"contacts" binding:"dive"`
+	AutoApprove      *bool            `json:"auto_approve" example:"true"` // Found items, finder only. Unchanged when omitted
 }
 
 type UpdateItemStatusRequest struct {
-	Status string `json:"status" binding:"required,oneof=RESOLVED CLAIMED"`
+	Status string `json:"status" binding:"required,oneof=OPEN CLAIMED RESOLVED EXPIRED ARCHIVED"`
+	Reason string `json:"reason" example:"Owner picked it up in person"` // Kept in the item's status history
+}
+
+type ItemStatusHistoryResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	FromStatus string     `json:"from_status"`
+	ToStatus   string     `json:"to_status"`
+	Actor      string     `json:"actor"` // FINDER, OWNER, SECURITY, CLAIM or SYSTEM
+	ActorID    *uuid.UUID `json:"actor_id,omitempty"`
+	ActorName  string     `json:"actor_name,omitempty"`
+	Reason     string     `json:"reason,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
 }
 
 type VerificationRequest struct {
@@ -87,10 +101,12 @@ type ItemResponse struct {
 	ShowPhone     bool                   `json:"show_phone"`             // For both item types
 	Contacts      []ContactResponse      `json:"contacts,omitempty"`     // For both item types
 	UserClaimStatus string               `json:"user_claim_status,omitempty"` // Status of claim by current user
+	AutoApprove     bool                 `json:"auto_approve,omitempty"`      // Only shown to the finder
 }
 
 type VerificationResponse struct {
-	Question string `json:"question"`
+	ID       uuid.UUID `json:"id"` // Referenced by claim answers
+	Question string    `json:"question"`
 	// Answer hidden
 }
 
@@ -100,8 +116,14 @@ type ContactResponse struct {
 }
 
 type CreateClaimRequest struct {
-	AnswerInput string `json:"answer_input" binding:"required" example:"Blue wallet with university ID"`
-	ImageURL    string `json:"image_url" example:"http://example.com/proof.jpg"`
+	Answers     []ClaimAnswerRequest `json:"answers" binding:"dive"` // One per verification question of the item
+	AnswerInput string               `json:"answer_input" example:"Blue wallet with university ID"` // Optional extra description
+	ImageURL    string               `json:"image_url" example:"http://example.com/proof.jpg"`
+}
+
+type ClaimAnswerRequest struct {
+	VerificationID uuid.UUID `json:"verification_id" binding:"required" example:"3f0c9a56-7d1e-4c1b-9a0e-2b7f5d8c4e11"`
+	Answer         string    `json:"answer" binding:"required" example:"Blue"`
 }
 
 type ClaimResponse struct {
@@ -117,4 +139,19 @@ type ClaimResponse struct {
 
 type DecideClaimRequest struct {
 	Status string `json:"status" binding:"required,oneof=APPROVED REJECTED"`
+	Reason string `json:"reason" example:"The answer does not match the item"` // Shown to the claimant when rejecting
+}
+
+type SearchResultResponse struct {
+	Kind       string     `json:"kind"` // ITEM or ASSET
+	ID         uuid.UUID  `json:"id"`
+	Title      string     `json:"title"`
+	Type       string     `json:"type"` // LOST/FOUND
+	Status     string     `json:"status"`
+	CategoryID uuid.UUID  `json:"category_id"`
+	LocationID *uuid.UUID `json:"location_id,omitempty"`
+	Urgency    string     `json:"urgency,omitempty"`
+	OccurredAt time.Time  `json:"occurred_at"`
+	Rank       float64    `json:"rank"`
+	Snippet    string     `json:"snippet"` // HTML-escaped, matched terms wrapped in <mark></mark>
 }
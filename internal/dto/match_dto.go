@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"campus-lost-and-found/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MatchResponse struct {
+	ID          uuid.UUID             `json:"id"`
+	LostItemID  *uuid.UUID            `json:"lost_item_id,omitempty"`
+	LostAssetID *uuid.UUID            `json:"lost_asset_id,omitempty"`
+	LostTitle   string                `json:"lost_title"`
+	FoundItemID uuid.UUID             `json:"found_item_id"`
+	FoundTitle  string                `json:"found_title"`
+	Score       float64               `json:"score"`
+	Breakdown   models.MatchBreakdown `json:"breakdown"`
+	Explanation string                `json:"explanation"`
+	Status      string                `json:"status"`
+	ClaimID     *uuid.UUID            `json:"claim_id,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+}
+
+type UpdateMatchRequest struct {
+	Status      string               `json:"status" binding:"required,oneof=ACCEPTED DISMISSED" example:"ACCEPTED"`
+	Answers     []ClaimAnswerRequest `json:"answers" binding:"dive"`                                // Answers to the found item's verification questions
+	AnswerInput string               `json:"answer_input" example:"Blue wallet with university ID"` // Used for the prefilled claim
+	ImageURL    string               `json:"image_url" example:"http://example.com/proof.jpg"`      // Shown to the finder, never prefilled from a lost asset's private image
+}
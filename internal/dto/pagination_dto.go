@@ -0,0 +1,8 @@
+package dto
+
+// Page wraps every paginated list response. Data holds the slice of response DTOs.
+type Page struct {
+	Data       interface{} `json:"data"`
+	NextCursor string      `json:"next_cursor,omitempty"` // Pass as ?cursor= to get the next page, empty on the last page
+	Total      int64       `json:"total"`                 // Total rows matching the filters, across all pages
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PickupCodeResponse struct {
+	ClaimID      uuid.UUID `json:"claim_id"`
+	ItemID       uuid.UUID `json:"item_id"`
+	Code         string    `json:"code" example:"482913"`
+	QRCode       string    `json:"qr_code"` // PNG data URL of the same code, for scanning at handover
+	ExpiresAt    time.Time `json:"expires_at"`
+	AttemptsLeft int       `json:"attempts_left"`
+}
+
+type HandoverRequest struct {
+	Code string `json:"code" binding:"required" example:"482913"` // Typed code or the scanned QR content
+	Note string `json:"note" example:"Checked the claimant's KTM"`
+}
+
+type HandoverResponse struct {
+	ItemID         uuid.UUID `json:"item_id"`
+	ItemStatus     string    `json:"item_status"`
+	ClaimID        uuid.UUID `json:"claim_id"`
+	ClaimStatus    string    `json:"claim_status"`
+	ClaimantID     uuid.UUID `json:"claimant_id"`
+	HandedOverByID uuid.UUID `json:"handed_over_by_id"`
+	HandedOverAt   time.Time `json:"handed_over_at"`
+}
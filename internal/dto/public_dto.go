@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PublicItemResponse is the redacted projection of an item or a lost asset for visitors
+// without an account. It never carries personal names, private images or verification
+// answers, and contacts only when the reporter chose to show them.
+type PublicItemResponse struct {
+	ID           uuid.UUID         `json:"id"`
+	Kind         string            `json:"kind"` // ITEM or ASSET
+	Title        string            `json:"title"`
+	Type         string            `json:"type"` // LOST or FOUND
+	Description  string            `json:"description"`
+	CategoryID   uuid.UUID         `json:"category_id"`
+	CategoryName string            `json:"category_name"`
+	LocationName string            `json:"location_name,omitempty"`
+	Status       string            `json:"status"`
+	Urgency      string            `json:"urgency,omitempty"`
+	OfferReward  bool              `json:"offer_reward"`
+	DateLost     string            `json:"date_lost,omitempty"`
+	DateFound    string            `json:"date_found,omitempty"`
+	ThumbnailURL string            `json:"thumbnail_url,omitempty"` // Small blurred preview, only for uploaded images
+	Contacts     []ContactResponse `json:"contacts,omitempty"`      // Only when the reporter enabled show_phone
+	CreatedAt    time.Time         `json:"created_at"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScanResponse is what a finder sees after scanning an asset's QR sticker. It never names
+// the owner or shows the private image.
+type ScanResponse struct {
+	AssetID      uuid.UUID `json:"asset_id"`
+	CategoryName string    `json:"category_name"`
+	LostMode     bool      `json:"lost_mode"`
+	Message      string    `json:"message"`
+
+	// Only set when the scanner shared lat and lng
+	NearestDesk *SecurityDeskResponse `json:"nearest_desk,omitempty"`
+	// Every security desk, closest first when the scanner shared coordinates
+	SecurityDesks []SecurityDeskResponse `json:"security_desks"`
+
+	// Must be answered to report the asset found without an account
+	Challenge ScanChallenge `json:"challenge"`
+}
+
+type SecurityDeskResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Latitude    float64   `json:"latitude"`
+	Longitude   float64   `json:"longitude"`
+	DistanceM   *float64  `json:"distance_m,omitempty"`
+}
+
+type ScanChallenge struct {
+	Question  string    `json:"question" example:"What is 3 + 4?"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type ScanReportFoundRequest struct {
+	LocationID      uuid.UUID `json:"location_id" binding:"required" example:"e9464495-bfe5-4ed0-8ea4-a2d69afa0b39"`
+	Note            string    `json:"note" example:"Left it with the librarian"`
+	ContactHandle   string    `json:"contact_handle" binding:"max=100" example:"@finder_on_telegram"` // Ignored when signed in
+	ChallengeToken  string    `json:"challenge_token" binding:"required"`
+	ChallengeAnswer string    `json:"challenge_answer" binding:"required" example:"7"`
+}
@@ -8,13 +8,32 @@ type UpdateUserRequest struct {
 	Phone string `json:"phone,omitempty" example:"08198765432"`
 }
 
-// UserDetailResponse for returning user details
+// UserDetailResponse for returning user details. Phone and identity number are left out
+// when another user's profile is viewed without permission to list users.
 type UserDetailResponse struct {
 	ID             uuid.UUID `json:"id"`
 	Name           string    `json:"name"`
 	Email          string    `json:"email"`
-	Phone          string    `json:"phone"`
-	IdentityNumber string    `json:"identity_number"`
+	Phone          string    `json:"phone,omitempty"`
+	IdentityNumber string    `json:"identity_number,omitempty"`
 	Role           string    `json:"role"`
 	Faculty        string    `json:"faculty,omitempty"`
 }
+
+// CreateUserRequest for admins creating accounts with any role, including ADMIN and SECURITY
+type CreateUserRequest struct {
+	Name           string `json:"name" binding:"required" example:"Joko Susilo"`
+	Email          string `json:"email" binding:"required,email" example:"198701012015041001@uii.ac.id"`
+	Password       string `json:"password" binding:"required,min=6" example:"password123"`
+	Phone          string `json:"phone" binding:"required" example:"081377788899"`
+	IdentityNumber string `json:"identity_number" binding:"required" example:"198701012015041001"`
+	Role           string `json:"role" binding:"required,oneof=PUBLIK MAHASISWA STAFF_DOSEN SECURITY ADMIN" example:"SECURITY"`
+	Faculty        string `json:"faculty" example:"Fakultas Teknologi Industri"`
+	Reason         string `json:"reason" example:"Security officer for the FTI building"` // Kept in the role audit trail
+}
+
+// ChangeRoleRequest for promoting or demoting a user
+type ChangeRoleRequest struct {
+	Role   string `json:"role" binding:"required,oneof=PUBLIK MAHASISWA STAFF_DOSEN SECURITY ADMIN" example:"SECURITY"`
+	Reason string `json:"reason" binding:"required" example:"Transferred to the campus security unit"`
+}
@@ -0,0 +1,293 @@
+package jobs
+
+import (
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/repository"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Job types
+const (
+	TypeMatchItem        = "MATCH_ITEM"
+	TypeSendNotification = "SEND_NOTIFICATION"
+	TypeGenerateQR       = "GENERATE_QR"
+	TypeRematchSweep     = "REMATCH_SWEEP"
+	TypeRetentionSweep   = "RETENTION_SWEEP"
+)
+
+// Payloads
+type ItemPayload struct {
+	ItemID uuid.UUID `json:"item_id"`
+}
+
+type AssetPayload struct {
+	AssetID uuid.UUID `json:"asset_id"`
+}
+
+type NotificationPayload struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Title   string    `json:"title"`
+	Body    string    `json:"body"`
+	RefType string    `json:"ref_type"`
+	RefID   uuid.UUID `json:"ref_id"`
+}
+
+// Handler runs one job. Returning an error schedules a retry with backoff.
+type Handler func(payload []byte) error
+
+type Config struct {
+	Workers      int
+	MaxAttempts  int
+	PollInterval time.Duration
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	StaleAfter   time.Duration // RUNNING jobs without a heartbeat for this long are assumed abandoned
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Workers:      4,
+		MaxAttempts:  5,
+		PollInterval: 2 * time.Second,
+		BaseBackoff:  10 * time.Second,
+		MaxBackoff:   time.Hour,
+		StaleAfter:   10 * time.Minute,
+	}
+}
+
+type Runner struct {
+	Repo      *repository.JobRepository
+	Config    Config
+	handlers  map[string]Handler
+	schedules map[string]time.Duration
+}
+
+func NewRunner(repo *repository.JobRepository, cfg Config) *Runner {
+	return &Runner{
+		Repo:      repo,
+		Config:    cfg,
+		handlers:  make(map[string]Handler),
+		schedules: make(map[string]time.Duration),
+	}
+}
+
+// Register sets the handler for a job type. Call before Start.
+func (r *Runner) Register(jobType string, handler Handler) {
+	r.handlers[jobType] = handler
+}
+
+// Every enqueues a job of this type on a fixed interval (with an empty payload),
+// skipping a tick while a previous one is still queued or running. Call before Start.
+func (r *Runner) Every(jobType string, interval time.Duration) {
+	r.schedules[jobType] = interval
+}
+
+// Enqueue stores a job to run as soon as a worker is free
+func (r *Runner) Enqueue(jobType string, payload interface{}) error {
+	job, err := r.NewJob(jobType, payload)
+	if err != nil {
+		return err
+	}
+	return r.Repo.Create(job)
+}
+
+// NewJob builds a job like Enqueue without storing it, for callers that save it in the same
+// transaction as the row it works on
+func (r *Runner) NewJob(jobType string, payload interface{}) (*models.Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Job{
+		Type:        jobType,
+		Payload:     string(data),
+		Status:      models.JobStatusPending,
+		MaxAttempts: r.Config.MaxAttempts,
+		RunAt:       time.Now(),
+	}, nil
+}
+
+// Start launches the worker pool. Workers stop when ctx is cancelled.
+func (r *Runner) Start(ctx context.Context) {
+	r.resetStale()
+	for i := 0; i < r.Config.Workers; i++ {
+		go r.work(ctx)
+	}
+
+	for jobType, interval := range r.schedules {
+		go r.schedule(ctx, jobType, interval)
+	}
+
+	go func() {
+		ticker := time.NewTicker(r.Config.StaleAfter)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.resetStale()
+			}
+		}
+	}()
+}
+
+func (r *Runner) schedule(ctx context.Context, jobType string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			active, err := r.Repo.HasActive(jobType)
+			if err != nil || active {
+				continue
+			}
+			if err := r.Enqueue(jobType, struct{}{}); err != nil {
+				log.Printf("jobs: failed to schedule %s: %v", jobType, err)
+			}
+		}
+	}
+}
+
+func (r *Runner) resetStale() {
+	if err := r.Repo.ResetStale(time.Now().Add(-r.Config.StaleAfter)); err != nil {
+		log.Println("jobs: failed to reset stale jobs:", err)
+	}
+}
+
+func (r *Runner) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		job, err := r.Repo.ClaimNext(time.Now())
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Println("jobs: failed to claim job:", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.Config.PollInterval):
+			}
+			continue
+		}
+
+		stop := r.heartbeat(job)
+		runErr := r.run(job)
+		stop()
+		r.finish(job, runErr)
+	}
+}
+
+// heartbeat refreshes the job's lock while it runs, so a long handler is not mistaken for an
+// abandoned one and handed to a second worker. The returned func stops it.
+func (r *Runner) heartbeat(job *models.Job) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(r.Config.StaleAfter / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				held, err := r.Repo.Heartbeat(job.ID, *job.LockedBy, time.Now())
+				if err != nil {
+					log.Printf("jobs: heartbeat of %s %s failed: %v", job.Type, job.ID, err)
+				} else if !held {
+					return
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// run calls the handler, turning a panic into an error so one bad job cannot kill a worker
+func (r *Runner) run(job *models.Job) (err error) {
+	handler, ok := r.handlers[job.Type]
+	if !ok {
+		return fmt.Errorf("no handler registered for job type %s", job.Type)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	return handler([]byte(job.Payload))
+}
+
+func (r *Runner) finish(job *models.Job, runErr error) {
+	lock := *job.LockedBy
+	job.LockedAt = nil
+	job.LockedBy = nil
+	if runErr == nil {
+		job.Status = models.JobStatusDone
+		job.LastError = ""
+	} else {
+		job.LastError = runErr.Error()
+		if job.Attempts >= job.MaxAttempts {
+			job.Status = models.JobStatusDead
+			log.Printf("jobs: %s %s is dead after %d attempts: %v", job.Type, job.ID, job.Attempts, runErr)
+		} else {
+			job.Status = models.JobStatusPending
+			job.RunAt = time.Now().Add(r.backoff(job.Attempts))
+		}
+	}
+
+	held, err := r.Repo.Finish(job, lock)
+	if err != nil {
+		log.Println("jobs: failed to update job:", err)
+	} else if !held {
+		log.Printf("jobs: %s %s lost its lock while running, result discarded", job.Type, job.ID)
+	}
+}
+
+// backoff doubles the delay after every attempt, capped at MaxBackoff
+func (r *Runner) backoff(attempts int) time.Duration {
+	delay := time.Duration(float64(r.Config.BaseBackoff) * math.Pow(2, float64(attempts-1)))
+	if delay > r.Config.MaxBackoff || delay <= 0 {
+		return r.Config.MaxBackoff
+	}
+	return delay
+}
+
+// FailedJobs lists dead jobs for the admin endpoint
+func (r *Runner) FailedJobs() ([]models.Job, error) {
+	return r.Repo.FindByStatus(string(models.JobStatusDead))
+}
+
+// Requeue gives a dead job a fresh set of attempts
+func (r *Runner) Requeue(id string) (*models.Job, error) {
+	job, err := r.Repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if job.Status != models.JobStatusDead {
+		return nil, errors.New("only dead jobs can be re-queued")
+	}
+
+	job.Status = models.JobStatusPending
+	job.Attempts = 0
+	job.RunAt = time.Now()
+	if err := r.Repo.Update(job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,276 @@
+// Package labels lays asset QR codes out on sticker sheets and renders them as vector PDF or
+// SVG, so the codes stay sharp at any printer resolution. Lengths are in millimetres from the
+// top-left corner of the sheet; font sizes are in points.
+package labels
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+const (
+	labelPadding = 1.5  // mm between the label edge, the QR code and the text
+	maxFontSize  = 10.0 // pt, for the category on tall labels
+	ptPerMM      = 72 / 25.4
+)
+
+// Layout describes a sheet of pre-cut stickers
+type Layout struct {
+	Name        string
+	Description string
+	PageWidth   float64
+	PageHeight  float64
+	Columns     int
+	Rows        int
+	LabelWidth  float64
+	LabelHeight float64
+	MarginLeft  float64
+	MarginTop   float64
+	PitchX      float64 // Distance between the left edges of neighbouring labels
+	PitchY      float64 // Distance between the top edges of neighbouring labels
+}
+
+// PerSheet is the number of labels on one sheet
+func (l Layout) PerSheet() int {
+	return l.Columns * l.Rows
+}
+
+// Layouts are the supported sticker papers, named <paper>-<columns>x<rows>
+var Layouts = map[string]Layout{
+	"a4-3x8": {
+		Name: "a4-3x8", Description: "A4, 24 labels of 63.5 x 33.9 mm (Avery L7159 and compatible)",
+		PageWidth: 210, PageHeight: 297, Columns: 3, Rows: 8,
+		LabelWidth: 63.5, LabelHeight: 33.9, MarginLeft: 6.4, MarginTop: 12.9, PitchX: 66.0, PitchY: 33.9,
+	},
+	"a4-2x7": {
+		Name: "a4-2x7", Description: "A4, 14 labels of 99.1 x 38.1 mm (Avery L7163 and compatible)",
+		PageWidth: 210, PageHeight: 297, Columns: 2, Rows: 7,
+		LabelWidth: 99.1, LabelHeight: 38.1, MarginLeft: 4.65, MarginTop: 15.15, PitchX: 101.6, PitchY: 38.1,
+	},
+	"a4-4x10": {
+		Name: "a4-4x10", Description: "A4, 40 labels of 45.7 x 25.4 mm (Avery L7654 and compatible)",
+		PageWidth: 210, PageHeight: 297, Columns: 4, Rows: 10,
+		LabelWidth: 45.7, LabelHeight: 25.4, MarginLeft: 9.7, MarginTop: 21.5, PitchX: 48.3, PitchY: 25.4,
+	},
+	"letter-3x10": {
+		Name: "letter-3x10", Description: "US Letter, 30 labels of 66.7 x 25.4 mm (Avery 5160 and compatible)",
+		PageWidth: 215.9, PageHeight: 279.4, Columns: 3, Rows: 10,
+		LabelWidth: 66.7, LabelHeight: 25.4, MarginLeft: 4.76, MarginTop: 12.7, PitchX: 69.85, PitchY: 25.4,
+	},
+}
+
+// FindLayout looks a layout up by name
+func FindLayout(name string) (Layout, error) {
+	layout, ok := Layouts[name]
+	if !ok {
+		return Layout{}, fmt.Errorf("unknown layout %q, use one of: %s", name, strings.Join(LayoutNames(), ", "))
+	}
+	return layout, nil
+}
+
+// LayoutNames lists the layout names in order
+func LayoutNames() []string {
+	names := make([]string, 0, len(Layouts))
+	for name := range Layouts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ErrorCorrection maps the QR error correction letters to go-qrcode levels. H survives about
+// 30% of the code being scratched or covered, L only 7% but makes for larger modules.
+var ErrorCorrection = map[string]qrcode.RecoveryLevel{
+	"L": qrcode.Low,
+	"M": qrcode.Medium,
+	"Q": qrcode.High,
+	"H": qrcode.Highest,
+}
+
+// Label is one sticker: the QR content and the text printed next to it
+type Label struct {
+	Content string // Encoded in the QR code
+	Title   string // Bold first line, e.g. the category
+	Message string // Wrapped below the title
+}
+
+// rect is a filled black rectangle
+type rect struct {
+	X, Y, W, H float64
+}
+
+// text is a single line; Y is the baseline
+type text struct {
+	X, Y  float64
+	Size  float64
+	Bold  bool
+	Value string
+}
+
+// page is what gets drawn on one sheet
+type page struct {
+	Rects []rect
+	Texts []text
+}
+
+// arrange fills as many sheets as the labels need, left to right and top to bottom
+func arrange(layout Layout, items []Label, level qrcode.RecoveryLevel) ([]page, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no labels to render")
+	}
+
+	var pages []page
+	for i, item := range items {
+		slot := i % layout.PerSheet()
+		if slot == 0 {
+			pages = append(pages, page{})
+		}
+		x := layout.MarginLeft + float64(slot%layout.Columns)*layout.PitchX
+		y := layout.MarginTop + float64(slot/layout.Columns)*layout.PitchY
+		if err := drawLabel(&pages[len(pages)-1], layout, x, y, item, level); err != nil {
+			return nil, err
+		}
+	}
+	return pages, nil
+}
+
+// drawLabel puts the QR code, quiet zone included, in a square on the left and the text in
+// the remaining space on the right, centred vertically
+func drawLabel(p *page, layout Layout, x, y float64, item Label, level qrcode.RecoveryLevel) error {
+	code, err := qrcode.New(item.Content, level)
+	if err != nil {
+		return err
+	}
+	bitmap := code.Bitmap()
+
+	size := layout.LabelHeight - 2*labelPadding
+	module := size / float64(len(bitmap))
+	for row, modules := range bitmap {
+		// Merge dark runs on a row into one rectangle to keep the output small
+		for col := 0; col < len(modules); col++ {
+			if !modules[col] {
+				continue
+			}
+			start := col
+			for col+1 < len(modules) && modules[col+1] {
+				col++
+			}
+			p.Rects = append(p.Rects, rect{
+				X: x + labelPadding + float64(start)*module,
+				Y: y + labelPadding + float64(row)*module,
+				W: float64(col-start+1) * module,
+				H: module,
+			})
+		}
+	}
+
+	textX := x + size + 2*labelPadding
+	room := layout.LabelWidth - size - 3*labelPadding
+	if room <= 0 {
+		return nil
+	}
+
+	titleSize := min(maxFontSize, layout.LabelHeight*0.3)
+	messageSize := titleSize * 0.85
+	lines := []text{{Size: titleSize, Bold: true, Value: truncate(item.Title, room, titleSize, true)}}
+	height := lineHeight(titleSize)
+	for _, line := range wrap(item.Message, room, messageSize) {
+		if height+lineHeight(messageSize) > size {
+			break
+		}
+		lines = append(lines, text{Size: messageSize, Value: line})
+		height += lineHeight(messageSize)
+	}
+
+	top := y + (layout.LabelHeight-height)/2
+	for _, line := range lines {
+		top += lineHeight(line.Size)
+		line.X = textX
+		line.Y = top - lineHeight(line.Size)*0.25 // baseline sits above the descenders
+		p.Texts = append(p.Texts, line)
+	}
+	return nil
+}
+
+// lineHeight in mm for a font size in points
+func lineHeight(size float64) float64 {
+	return size * 1.2 / ptPerMM
+}
+
+// wrap breaks a message into lines that fit width, cutting words that are too long on their own
+func wrap(message string, width, size float64) []string {
+	var lines []string
+	current := ""
+	for _, word := range strings.Fields(message) {
+		candidate := word
+		if current != "" {
+			candidate = current + " " + word
+		}
+		if textWidth(candidate, size, false) <= width {
+			current = candidate
+			continue
+		}
+		if current != "" {
+			lines = append(lines, current)
+		}
+		current = truncate(word, width, size, false)
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// truncate shortens s with "..." until it fits width
+func truncate(s string, width, size float64, bold bool) string {
+	if textWidth(s, size, bold) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		if candidate := strings.TrimSpace(string(runes)) + "..."; textWidth(candidate, size, bold) <= width {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// textWidth in mm, from the Helvetica metrics every PDF reader ships with
+func textWidth(s string, size float64, bold bool) float64 {
+	widths := helveticaWidths
+	if bold {
+		widths = helveticaBoldWidths
+	}
+	units := 0
+	for _, r := range s {
+		if r >= 32 && r <= 126 {
+			units += widths[r-32]
+		} else {
+			units += 556
+		}
+	}
+	return float64(units) / 1000 * size / ptPerMM
+}
+
+// Glyph widths of ASCII 32-126 in 1/1000 em (Adobe Helvetica AFM, WinAnsi encoding)
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
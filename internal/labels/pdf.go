@@ -0,0 +1,99 @@
+package labels
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// RenderPDF draws the labels on as many sheets as needed. The PDF only uses the standard
+// Helvetica fonts, which readers provide, so nothing is embedded.
+func RenderPDF(layout Layout, items []Label, level qrcode.RecoveryLevel) ([]byte, error) {
+	pages, err := arrange(layout, items, level)
+	if err != nil {
+		return nil, err
+	}
+
+	// Objects: 1 catalog, 2 page tree, 3 and 4 fonts, then a page and its content per sheet
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // page tree, once the pages are known
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	var kids []string
+	for _, p := range pages {
+		content := pdfContent(layout, p)
+		pageID := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageID))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pdfNum(layout.PageWidth*ptPerMM), pdfNum(layout.PageHeight*ptPerMM), pageID+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes(), nil
+}
+
+// pdfContent is the page's drawing operators. PDF measures in points from the bottom left.
+func pdfContent(layout Layout, p page) string {
+	var b strings.Builder
+	b.WriteString("0 g\n")
+	for _, r := range p.Rects {
+		fmt.Fprintf(&b, "%s %s %s %s re\n",
+			pdfNum(r.X*ptPerMM), pdfNum((layout.PageHeight-r.Y-r.H)*ptPerMM), pdfNum(r.W*ptPerMM), pdfNum(r.H*ptPerMM))
+	}
+	if len(p.Rects) > 0 {
+		b.WriteString("f\n")
+	}
+	for _, t := range p.Texts {
+		font := "F1"
+		if t.Bold {
+			font = "F2"
+		}
+		fmt.Fprintf(&b, "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+			font, pdfNum(t.Size), pdfNum(t.X*ptPerMM), pdfNum((layout.PageHeight-t.Y)*ptPerMM), pdfString(t.Value))
+	}
+	return b.String()
+}
+
+func pdfNum(v float64) string {
+	return strconv.FormatFloat(v, 'f', 3, 64)
+}
+
+// pdfString escapes a literal string. Characters outside ASCII are printed as "?", the
+// standard fonts have no glyphs to map them to.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package labels
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// RenderSVG draws the labels on a single sheet, in millimetres, for printing at 100% scale
+func RenderSVG(layout Layout, items []Label, level qrcode.RecoveryLevel) ([]byte, error) {
+	if len(items) > layout.PerSheet() {
+		return nil, fmt.Errorf("an SVG holds one sheet of %d labels, use the PDF format for more", layout.PerSheet())
+	}
+	pages, err := arrange(layout, items, level)
+	if err != nil {
+		return nil, err
+	}
+	p := pages[0]
+
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%smm" height="%smm" viewBox="0 0 %s %s">`+"\n",
+		svgNum(layout.PageWidth), svgNum(layout.PageHeight), svgNum(layout.PageWidth), svgNum(layout.PageHeight))
+
+	b.WriteString(`<path fill="#000" shape-rendering="crispEdges" d="`)
+	for _, r := range p.Rects {
+		fmt.Fprintf(&b, "M%s %sh%sv%sh-%sz", svgNum(r.X), svgNum(r.Y), svgNum(r.W), svgNum(r.H), svgNum(r.W))
+	}
+	b.WriteString("\"/>\n")
+
+	for _, t := range p.Texts {
+		weight := "normal"
+		if t.Bold {
+			weight = "bold"
+		}
+		fmt.Fprintf(&b, `<text x="%s" y="%s" font-family="Helvetica, Arial, sans-serif" font-size="%s" font-weight="%s">%s</text>`+"\n",
+			svgNum(t.X), svgNum(t.Y), svgNum(t.Size/ptPerMM), weight, html.EscapeString(t.Value))
+	}
+	b.WriteString("</svg>\n")
+	return []byte(b.String()), nil
+}
+
+func svgNum(v float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.3f", v), "0"), ".")
+}
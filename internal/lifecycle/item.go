@@ -0,0 +1,108 @@
+// Package lifecycle is the item state machine: which status changes are allowed and who
+// may make them. Every change goes through ItemRepository, which records it in
+// item_status_history.
+package lifecycle
+
+import (
+	"campus-lost-and-found/internal/models"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Actor is the capacity in which a status change is made
+type Actor string
+
+const (
+	ActorFinder   Actor = "FINDER"   // The user who reported the found item
+	ActorOwner    Actor = "OWNER"    // The user who reported the lost item
+	ActorSecurity Actor = "SECURITY" // SECURITY or ADMIN staff
+	ActorClaim    Actor = "CLAIM"    // The claim process: approval, withdrawal and handover
+	ActorSystem   Actor = "SYSTEM"   // Scheduled jobs
+)
+
+// Change is who moves an item and why, as written to the history
+type Change struct {
+	Actor   Actor
+	ActorID *uuid.UUID // Nil for scheduled jobs
+	Reason  string
+}
+
+type transition struct {
+	from, to models.ItemStatus
+}
+
+// Transitions lists every allowed status change and who may make it. An item only becomes
+// CLAIMED through an approved claim, and ARCHIVED is final.
+var Transitions = map[transition][]Actor{
+	{models.ItemStatusOpen, models.ItemStatusClaimed}:      {ActorClaim},
+	{models.ItemStatusOpen, models.ItemStatusResolved}:     {ActorFinder, ActorOwner, ActorSecurity, ActorClaim},
+	{models.ItemStatusOpen, models.ItemStatusExpired}:      {ActorSecurity, ActorSystem},
+	{models.ItemStatusOpen, models.ItemStatusArchived}:     {ActorFinder, ActorOwner, ActorSecurity},
+	{models.ItemStatusClaimed, models.ItemStatusOpen}:      {ActorClaim},
+	{models.ItemStatusClaimed, models.ItemStatusResolved}:  {ActorClaim},
+	{models.ItemStatusExpired, models.ItemStatusOpen}:      {ActorFinder, ActorOwner, ActorSecurity},
+	{models.ItemStatusExpired, models.ItemStatusArchived}:  {ActorSecurity, ActorSystem},
+	{models.ItemStatusResolved, models.ItemStatusArchived}: {ActorSecurity, ActorSystem},
+}
+
+// Allow checks that actor may move an item from one status to another
+func Allow(from, to models.ItemStatus, actor Actor) error {
+	_, err := Pick(from, to, []Actor{actor})
+	return err
+}
+
+// Pick returns the first of the user's capacities that may make the change
+func Pick(from, to models.ItemStatus, actors []Actor) (Actor, error) {
+	if from == to {
+		return "", fmt.Errorf("item is already %s", to)
+	}
+	allowed, ok := Transitions[transition{from, to}]
+	if !ok {
+		return "", fmt.Errorf("item cannot move from %s to %s", from, to)
+	}
+	for _, actor := range actors {
+		for _, a := range allowed {
+			if a == actor {
+				return actor, nil
+			}
+		}
+	}
+
+	names := make([]string, len(allowed))
+	for i, a := range allowed {
+		names[i] = describe(a)
+	}
+	return "", fmt.Errorf("only %s can move an item from %s to %s", strings.Join(names, " or "), from, to)
+}
+
+// ActorsFor lists the capacities in which a user can act on an item
+func ActorsFor(item *models.Item, userID uuid.UUID, role string) []Actor {
+	var actors []Actor
+	if item.FinderID != nil && *item.FinderID == userID {
+		actors = append(actors, ActorFinder)
+	}
+	if item.OwnerID != nil && *item.OwnerID == userID {
+		actors = append(actors, ActorOwner)
+	}
+	if role == string(models.RoleSecurity) || role == string(models.RoleAdmin) {
+		actors = append(actors, ActorSecurity)
+	}
+	return actors
+}
+
+func describe(a Actor) string {
+	switch a {
+	case ActorFinder:
+		return "the finder"
+	case ActorOwner:
+		return "the owner"
+	case ActorSecurity:
+		return "security"
+	case ActorClaim:
+		return "the claim process"
+	default:
+		return "the system"
+	}
+}
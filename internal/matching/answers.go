@@ -0,0 +1,104 @@
+package matching
+
+import (
+	"campus-lost-and-found/internal/models"
+	"math"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Thresholds for the per-question indicator shown to the finder
+const (
+	answerCloseScore   = 0.8
+	answerPartialScore = 0.5
+)
+
+// NormalizeAnswer lowercases, drops accents and punctuation and collapses whitespace,
+// so "Blue!", " blue " and "Blué" compare equal
+func NormalizeAnswer(answer string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), answer)
+	if err != nil {
+		stripped = answer
+	}
+	words := strings.FieldsFunc(strings.ToLower(stripped), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return strings.Join(words, " ")
+}
+
+// ScoreAnswer compares a claimant's answer to the stored one. The score (0..1) is the better
+// of edit-distance similarity, which forgives typos, and keyword recall, which forgives a few
+// extra words around the right answer ("it is blue" for "blue").
+func ScoreAnswer(expected, given string) (float64, models.AnswerMatch) {
+	a, b := NormalizeAnswer(expected), NormalizeAnswer(given)
+	if a == "" || b == "" {
+		return 0, models.AnswerMatchNone
+	}
+	if a == b {
+		return 1, models.AnswerMatchExact
+	}
+
+	score := math.Max(similarity(a, b), keywordRecall(tokenize(a), tokenize(b)))
+	switch {
+	case score >= answerCloseScore:
+		return score, models.AnswerMatchClose
+	case score >= answerPartialScore:
+		return score, models.AnswerMatchPartial
+	default:
+		return score, models.AnswerMatchNone
+	}
+}
+
+// keywordRecall is the share of expected words found in the answer. Answers with more than
+// two extra words are scaled down, so listing every colour does not pass for "blue".
+func keywordRecall(expected, given map[string]bool) float64 {
+	if len(expected) == 0 || len(given) == 0 {
+		return 0
+	}
+	found := 0
+	for t := range expected {
+		if given[t] {
+			found++
+		}
+	}
+	recall := float64(found) / float64(len(expected))
+	allowed := float64(len(expected) + 2)
+	if words := float64(len(given)); words > allowed {
+		recall *= allowed / words
+	}
+	return recall
+}
+
+// similarity is 1 - levenshtein(a, b) / max(len(a), len(b)), over runes
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := math.Max(float64(len(ra)), float64(len(rb)))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/longest
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -4,6 +4,10 @@ import (
 	"campus-lost-and-found/internal/models"
 	"fmt"
 	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 )
@@ -12,12 +16,142 @@ type NotificationService interface {
 	CreateNotification(userID uuid.UUID, title, body, refType string, refID uuid.UUID) error
 }
 
+// MatchStore persists suggestions. CreateIfNew returns false when the pair was
+// already suggested (in any status, including dismissed), so it is not notified twice.
+type MatchStore interface {
+	CreateIfNew(match *models.Match) (bool, error)
+}
+
+// Weights controls how much each signal contributes to the final score.
+// They are relative, so {35, 25, 20, 20} and {0.35, 0.25, 0.2, 0.2} behave the same.
+type Weights struct {
+	Category float64
+	Location float64
+	Time     float64
+	Text     float64
+}
+
+func DefaultWeights() Weights {
+	return Weights{Category: 35, Location: 25, Time: 20, Text: 20}
+}
+
+const (
+	DefaultThreshold = 60.0
+
+	// Distance (metres) below which location is a full match, and above which it scores zero
+	nearDistance = 100.0
+	farDistance  = 1000.0
+
+	// Hours between lost and found below which time is a full match, and above which it scores zero
+	nearWindow = 24.0
+	farWindow  = 7 * 24.0
+)
+
+// SignalScore and ScoreBreakdown live in models so they can be stored on a Match
+type SignalScore = models.MatchSignal
+type ScoreBreakdown = models.MatchBreakdown
+
+// Report is the common shape the engine scores, built from either an Item or an Asset
+type Report struct {
+	ID          uuid.UUID
+	Kind        string // ITEM or ASSET
+	UserID      uuid.UUID
+	CategoryID  uuid.UUID
+	Title       string
+	Description string
+	Location    *models.CampusLocation
+	OccurredAt  time.Time
+}
+
+const (
+	KindItem  = "ITEM"
+	KindAsset = "ASSET"
+)
+
+// Candidate is a lost report paired with a found report, with the score between them
+type Candidate struct {
+	Lost      Report
+	Found     Report
+	Breakdown ScoreBreakdown
+}
+
+// Label is the short name used in notifications
+func (r Report) Label() string {
+	if r.Title != "" {
+		return r.Title
+	}
+	return r.Description
+}
+
 type MatchingEngine struct {
 	NotifService NotificationService
+	Store        MatchStore
+	Weights      Weights
+	Threshold    float64
+}
+
+func NewMatchingEngine(notifService NotificationService, store MatchStore, weights Weights, threshold float64) *MatchingEngine {
+	return &MatchingEngine{
+		NotifService: notifService,
+		Store:        store,
+		Weights:      weights,
+		Threshold:    threshold,
+	}
 }
 
-func NewMatchingEngine(notifService NotificationService) *MatchingEngine {
-	return &MatchingEngine{NotifService: notifService}
+// FromItem builds a Report from an ad-hoc item. Location must be preloaded to be scored.
+func FromItem(item *models.Item) Report {
+	r := Report{
+		ID:          item.ID,
+		Kind:        KindItem,
+		CategoryID:  item.CategoryID,
+		Title:       item.Title,
+		Description: item.Description + " " + item.LocationDescription,
+		Location:    item.Location,
+		OccurredAt:  item.CreatedAt,
+	}
+	if item.Type == models.ItemTypeFound {
+		if item.FinderID != nil {
+			r.UserID = *item.FinderID
+		}
+		if item.DateFound != nil {
+			r.OccurredAt = *item.DateFound
+		}
+	} else {
+		if item.OwnerID != nil {
+			r.UserID = *item.OwnerID
+		}
+		if item.DateLost != nil {
+			r.OccurredAt = *item.DateLost
+		}
+	}
+	return r
+}
+
+// FromAsset builds a Report from a registered asset in lost mode, using its open lost
+// episode (preloaded as the first LostEpisodes entry) for location and time.
+// Without an episode, UpdatedAt is the best guess for when lost mode was turned on.
+func FromAsset(asset *models.Asset) Report {
+	r := Report{
+		ID:          asset.ID,
+		Kind:        KindAsset,
+		UserID:      asset.OwnerID,
+		CategoryID:  asset.CategoryID,
+		Description: asset.Description,
+		OccurredAt:  asset.UpdatedAt,
+	}
+	if len(asset.LostEpisodes) > 0 {
+		episode := asset.LostEpisodes[0]
+		r.Location = episode.Location
+		r.Description += " " + episode.LocationDescription
+		r.OccurredAt = episode.LostSince
+	}
+	return r
+}
+
+// Distance is the great-circle distance in metres between two coordinates
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	return calculateDistance(lat1, lon1, lat2, lon2)
 }
 
 // Haversine distance calculation
@@ -37,110 +171,225 @@ func calculateDistance(lat1, lon1, lat2, lon2 float64) float64 {
 	return d
 }
 
-func (e *MatchingEngine) RunMatching(foundItem *models.Item, lostAssets []models.Asset) {
-	// Filter lost assets where:
-	// lost_mode = true (already filtered by caller)
-	// category matches
+// linearDecay returns 100 up to near, 0 from far, and a straight line in between
+func linearDecay(value, near, far float64) float64 {
+	if value <= near {
+		return 100
+	}
+	if value >= far {
+		return 0
+	}
+	return 100 * (far - value) / (far - near)
+}
+
+// Score compares a lost report with a found report
+func (e *MatchingEngine) Score(lost, found Report) ScoreBreakdown {
+	var b ScoreBreakdown
+
+	// Category
+	b.Category.Available = true
+	if lost.CategoryID == found.CategoryID {
+		b.Category.Score = 100
+	}
+
+	// Location
+	if lost.Location != nil && found.Location != nil {
+		d := calculateDistance(lost.Location.Latitude, lost.Location.Longitude, found.Location.Latitude, found.Location.Longitude)
+		b.DistanceM = &d
+		b.Location = SignalScore{Score: linearDecay(d, nearDistance, farDistance), Available: true}
+	}
+
+	// Time: the item should be found after it was lost. Dates are often day-precision,
+	// so allow a day of slack before treating "found before lost" as a mismatch.
+	if !lost.OccurredAt.IsZero() && !found.OccurredAt.IsZero() {
+		gap := found.OccurredAt.Sub(lost.OccurredAt).Hours()
+		b.TimeGapHrs = &gap
+		b.Time.Available = true
+		if gap >= -nearWindow {
+			b.Time.Score = linearDecay(math.Abs(gap), nearWindow, farWindow)
+		}
+	}
+
+	// Text
+	lostTokens := tokenize(lost.Title + " " + lost.Description)
+	foundTokens := tokenize(found.Title + " " + found.Description)
+	if len(lostTokens) > 0 && len(foundTokens) > 0 {
+		b.Text = SignalScore{Score: overlap(lostTokens, foundTokens) * 100, Available: true}
+	}
+
+	b.Total = e.total(b)
+	return b
+}
+
+// total is the weighted average of the available signals
+func (e *MatchingEngine) total(b ScoreBreakdown) float64 {
+	var sum, weights float64
+	add := func(s SignalScore, w float64) {
+		if s.Available && w > 0 {
+			sum += s.Score * w
+			weights += w
+		}
+	}
+	add(b.Category, e.Weights.Category)
+	add(b.Location, e.Weights.Location)
+	add(b.Time, e.Weights.Time)
+	add(b.Text, e.Weights.Text)
+
+	if weights == 0 {
+		return 0
+	}
+	return math.Round(sum/weights*100) / 100
+}
 
-	for _, asset := range lostAssets {
-		if asset.CategoryID != foundItem.CategoryID {
+// rank scores every pair and sorts them best first. Pairs reported by the same user are skipped,
+// and so are pairs in different categories: no location, time or text score makes up for that.
+func (e *MatchingEngine) rank(pairs []Candidate) []Candidate {
+	candidates := make([]Candidate, 0, len(pairs))
+	for _, c := range pairs {
+		if c.Lost.UserID != uuid.Nil && c.Lost.UserID == c.Found.UserID {
+			continue
+		}
+		if c.Lost.CategoryID != c.Found.CategoryID {
 			continue
 		}
+		c.Breakdown = e.Score(c.Lost, c.Found)
+		candidates = append(candidates, c)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Breakdown.Total > candidates[j].Breakdown.Total
+	})
+	return candidates
+}
+
+// MatchFound scores a newly found report against open lost reports (items and assets)
+func (e *MatchingEngine) MatchFound(found Report, lost []Report) []Candidate {
+	pairs := make([]Candidate, 0, len(lost))
+	for _, l := range lost {
+		pairs = append(pairs, Candidate{Lost: l, Found: found})
+	}
+	return e.notifyAll(e.rank(pairs))
+}
 
-		// Location Distance Score
-		// Assuming Asset has a "Last Known Location" or we use the FoundEvent location if it was scanned?
-		// Wait, Asset table doesn't have location.
-		// However, the prompt says "Score asset based on: location distance (< 500m)".
-		// This implies Asset MUST have a location.
-		// But the schema for Asset is: id, owner_id, category_id, description, private_image_url, lost_mode, qr_code_url.
-		// It does NOT have location.
-		// Maybe it uses the "Found Events" location if the asset was scanned?
-		// Or maybe the User inputs a location when marking as lost?
-		// The prompt says "POST /assets/:id/lost-mode". Maybe we should accept location there?
-		// But the schema is fixed.
-		// Let's look at "Found Events". "found_events" has "location_id".
-		// If an asset was scanned and reported found, it has a location.
-		// But "Matching Engine" is for "Finder-First" flow: "POST /items/found ... run Smart Matching Engine".
-		// This matches a NEWLY FOUND item against LOST assets.
-		// If the asset is just "Lost" without location, we can't match by distance.
-		// UNLESS the "Lost Mode" implies we know where it was lost?
-		// Or maybe we match against "Found Events" of that asset?
-		// No, "Found Events" are when someone finds it and scans QR.
-		// "Items" are when someone finds something WITHOUT QR (Finder-First).
-		// So we are matching Found Item (with location) against Lost Asset (without location?).
-		// This is a gap.
-		// However, I must follow the prompt.
-		// "Score asset based on: location distance (< 500m)".
-		// I will assume for now that we skip location score if asset has no location, OR
-		// maybe I missed something.
-		// Ah, "campus_locations" has lat/long.
-		// Maybe the Asset has a "Home Location" or "Last Scanned Location"?
-		// Let's assume we can't score location if we don't know it.
-		// BUT, if the user reported it lost, maybe they provided a location?
-		// The prompt for "PUT /assets/:id/lost-mode" doesn't specify body.
-		// I'll assume for now we only match on Category and Time (if we had time of loss).
-		// Wait, "time difference (< 24h)". Asset has `updated_at` which could be when it was marked lost.
-		// So we can use `updated_at` of lost mode vs `created_at` of found item.
-		
-		// Let's check if I can add location to Asset or if I should just use what I have.
-		// I will use `updated_at` for time score.
-		// For location, since I cannot change schema, I will skip it or assume 0 distance if unknown (which is bad).
-		// Actually, maybe the "Found Events" are relevant?
-		// If an asset was scanned (FoundEvent), it has a location.
-		// If I find an item, maybe it matches a FoundEvent?
-		// No, FoundEvent is "I found this QR code". Item is "I found this object (no QR)".
-		
-		// Let's assume the prompt implies we should have location for lost assets.
-		// But since I can't change schema, I will implement logic that checks if we have any location info.
-		// Maybe I can check if there are any "FoundEvents" for this asset?
-		// If there are, use the latest one's location?
-		// That makes sense: Asset was scanned at Loc A (FoundEvent), then someone reports Found Item at Loc A.
-		// But if it was scanned, the owner is already notified.
-		
-		// Let's just implement Time Score and Category Match.
-		// And if I can't do location, I'll note it.
-		// Wait, `campus_locations` table exists.
-		// Maybe `Asset` should have `LocationID`? No.
-		// I will stick to Category and Time.
-		// And I'll add a dummy "Distance Score" that is always 100% if we can't calculate, or 0.
-		// Actually, let's look at `Item` (Finder-First). It has `LocationID`.
-		// If `Asset` has no location, we can't match distance.
-		// I will implement the score logic but comment about the missing location on Asset.
-		
-		// Time Score
-		// Difference between Found Item CreatedAt and Asset UpdatedAt (Lost Mode Time)
-		timeDiff := foundItem.CreatedAt.Sub(asset.UpdatedAt).Hours()
-		if timeDiff < 0 {
-			timeDiff = -timeDiff
+// MatchLost scores a newly lost report against open found reports
+func (e *MatchingEngine) MatchLost(lost Report, found []Report) []Candidate {
+	pairs := make([]Candidate, 0, len(found))
+	for _, f := range found {
+		pairs = append(pairs, Candidate{Lost: lost, Found: f})
+	}
+	return e.notifyAll(e.rank(pairs))
+}
+
+// notifyAll stores and notifies both sides of every candidate that reaches the threshold.
+// Pairs that were already suggested are skipped. All candidates are returned with their breakdown.
+func (e *MatchingEngine) notifyAll(candidates []Candidate) []Candidate {
+	for _, c := range candidates {
+		if c.Breakdown.Total < e.Threshold {
+			continue
 		}
-		
-		timeScore := 0.0
-		if timeDiff < 24 {
-			timeScore = 100.0
-		} else if timeDiff < 48 {
-			timeScore = 50.0
+		if e.Store != nil {
+			created, err := e.Store.CreateIfNew(toMatch(c))
+			if err != nil || !created {
+				continue
+			}
+		}
+		e.Notify(c)
+	}
+	return candidates
+}
+
+// toMatch converts a candidate into a suggestion. Only items can be found reports.
+func toMatch(c Candidate) *models.Match {
+	match := &models.Match{
+		FoundItemID: c.Found.ID,
+		OwnerID:     c.Lost.UserID,
+		Score:       c.Breakdown.Total,
+		Breakdown:   c.Breakdown,
+		Status:      models.MatchStatusSuggested,
+	}
+	if c.Found.UserID != uuid.Nil {
+		finderID := c.Found.UserID
+		match.FinderID = &finderID
+	}
+	lostID := c.Lost.ID
+	if c.Lost.Kind == KindAsset {
+		match.LostAssetID = &lostID
+	} else {
+		match.LostItemID = &lostID
+	}
+	return match
+}
+
+// Notify tells the owner and the finder about a candidate
+func (e *MatchingEngine) Notify(c Candidate) {
+	if c.Lost.UserID != uuid.Nil {
+		e.NotifService.CreateNotification(
+			c.Lost.UserID,
+			"Potential Match Found!",
+			fmt.Sprintf("An item matching your lost report '%s' was reported found (%s).", c.Lost.Label(), Explain(c.Breakdown)),
+			"POTENTIAL_MATCH",
+			c.Found.ID,
+		)
+	}
+	if c.Found.UserID != uuid.Nil {
+		e.NotifService.CreateNotification(
+			c.Found.UserID,
+			"Possible Owner Found!",
+			fmt.Sprintf("Someone reported losing an item like '%s' that you found (%s).", c.Found.Label(), Explain(c.Breakdown)),
+			"POTENTIAL_OWNER",
+			c.Found.ID,
+		)
+	}
+}
+
+// Explain renders the breakdown as a short human readable reason
+func Explain(b ScoreBreakdown) string {
+	var parts []string
+	if b.Category.Available && b.Category.Score == 100 {
+		parts = append(parts, "same category")
+	}
+	if b.DistanceM != nil {
+		parts = append(parts, fmt.Sprintf("%.0f m apart", *b.DistanceM))
+	}
+	if b.TimeGapHrs != nil {
+		parts = append(parts, fmt.Sprintf("%.0f hours apart", math.Abs(*b.TimeGapHrs)))
+	}
+	if b.Text.Available && b.Text.Score > 0 {
+		parts = append(parts, fmt.Sprintf("%.0f%% similar description", b.Text.Score))
+	}
+	parts = append(parts, fmt.Sprintf("score %.0f", b.Total))
+	return strings.Join(parts, ", ")
+}
+
+// Common Indonesian and English words that carry no meaning for matching
+var stopWords = map[string]bool{
+	"the": true, "and": true, "with": true, "for": true, "near": true, "from": true, "was": true, "this": true, "that": true, "my": true,
+	"yang": true, "dan": true, "di": true, "ke": true, "dari": true, "dengan": true, "ini": true, "itu": true, "warna": true, "saya": true,
+}
+
+func tokenize(text string) map[string]bool {
+	tokens := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		if len(word) < 2 || stopWords[word] {
+			continue
 		}
+		tokens[word] = true
+	}
+	return tokens
+}
 
-		// Final Score (Weighted)
-		// If we had location, it would be 50% Loc + 50% Time.
-		// Without location, let's just use Time?
-		// Or maybe the prompt implies we SHOULD have location in Asset?
-		// "assets (Owner-First) ... category_id ... description ... lost_mode ..."
-		// No location.
-		// I will proceed with Time Score only for now, but threshold is 80%.
-		// So if Time < 24h, score is 100 >= 80 -> Match.
-		
-		finalScore := timeScore
-
-		if finalScore >= 80 {
-			// Notify Owner
-			e.NotifService.CreateNotification(
-				asset.OwnerID,
-				"Potential Match Found!",
-				fmt.Sprintf("An item matching your lost asset '%s' was reported found.", asset.Description),
-				"POTENTIAL_MATCH",
-				foundItem.ID,
-			)
+// overlap is the overlap coefficient |A∩B| / min(|A|,|B|), so a short title
+// fully contained in a longer description still scores high
+func overlap(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for t := range a {
+		if b[t] {
+			common++
 		}
 	}
+	return float64(common) / math.Min(float64(len(a)), float64(len(b)))
 }
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"campus-lost-and-found/config"
+	"campus-lost-and-found/internal/repository"
 	"campus-lost-and-found/internal/utils"
 	"net/http"
 	"strings"
@@ -9,7 +11,10 @@ import (
 	"github.com/google/uuid"
 )
 
+// AuthMiddleware validates the bearer token. The role is read from the database rather than
+// the token, so a role change or a deleted account takes effect on tokens already issued.
 func AuthMiddleware() gin.HandlerFunc {
+	users := repository.NewUserRepository(config.GetDB())
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -20,6 +25,14 @@ func AuthMiddleware() gin.HandlerFunc {
 
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 		claims, err := utils.ValidateToken(tokenString)
+		// A refresh token is only good for POST /auth/refresh, never as an access token
+		if err != nil || claims.Role == "REFRESH" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			c.Abort()
+			return
+		}
+
+		role, err := users.FindRole(claims.UserID)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
 			c.Abort()
@@ -27,11 +40,24 @@ func AuthMiddleware() gin.HandlerFunc {
 		}
 
 		c.Set("userID", claims.UserID)
-		c.Set("role", claims.Role)
+		c.Set("role", string(role))
 		c.Next()
 	}
 }
 
+// OptionalAuthMiddleware is AuthMiddleware for routes guests may also use: without an
+// Authorization header the request goes through anonymously, an invalid token is still rejected
+func OptionalAuthMiddleware() gin.HandlerFunc {
+	auth := AuthMiddleware()
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		auth(c)
+	}
+}
+
 func RoleGuard(roles ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userRole := c.GetString("role")
@@ -50,3 +76,13 @@ func GetUserID(c *gin.Context) uuid.UUID {
 	id, _ := c.Get("userID")
 	return id.(uuid.UUID)
 }
+
+// OptionalUserID is the caller's ID behind OptionalAuthMiddleware, nil for guests
+func OptionalUserID(c *gin.Context) *uuid.UUID {
+	id, ok := c.Get("userID")
+	if !ok {
+		return nil
+	}
+	userID := id.(uuid.UUID)
+	return &userID
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"campus-lost-and-found/internal/models"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Permission names a guarded action. Routes without a permission only need a valid token.
+type Permission string
+
+const (
+	PermManageEnumerations Permission = "enumerations:manage" // create item categories and campus locations
+	PermListUsers          Permission = "users:list"          // list every user with phone and identity number
+	PermManageUsers        Permission = "users:manage"        // create accounts with any role and change roles
+	PermManageJobs         Permission = "jobs:manage"         // inspect and re-queue failed background jobs
+	PermManageCustody      Permission = "custody:manage"      // check in, transfer and check out items at security desks
+	PermReviewClaims       Permission = "claims:review"       // decide appealed (escalated) claims
+	PermManageDisposals    Permission = "disposals:manage"    // work the queue of expired items and record their disposition
+)
+
+// Permissions is the role matrix for PUBLIK, MAHASISWA, STAFF_DOSEN, SECURITY and ADMIN
+var Permissions = map[Permission][]models.UserRole{
+	PermManageEnumerations: {models.RoleAdmin},
+	PermListUsers:          {models.RoleAdmin},
+	PermManageUsers:        {models.RoleAdmin},
+	PermManageJobs:         {models.RoleAdmin},
+	PermManageCustody:      {models.RoleSecurity, models.RoleAdmin},
+	PermReviewClaims:       {models.RoleSecurity, models.RoleAdmin},
+	PermManageDisposals:    {models.RoleSecurity, models.RoleAdmin},
+}
+
+// Allowed reports whether role has permission, for handlers that only hide part of a response
+func Allowed(role string, permission Permission) bool {
+	for _, r := range Permissions[permission] {
+		if string(r) == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Require guards a route with the roles allowed by the matrix (403 for everyone else).
+// Must run after AuthMiddleware.
+func Require(permission Permission) gin.HandlerFunc {
+	roles, ok := Permissions[permission]
+	if !ok {
+		panic(fmt.Sprintf("middleware: unknown permission %q", permission))
+	}
+
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+	return RoleGuard(names...)
+}
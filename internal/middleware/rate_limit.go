@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// idleBucketTTL is how long an unused bucket is kept before it is pruned
+const idleBucketTTL = 10 * time.Minute
+
+// RateLimiter is an in-memory token bucket per key (a client IP, an asset, ...). Limits are
+// per server instance.
+type RateLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	rate      float64 // tokens per second
+	burst     float64
+	lastPrune time.Time
+}
+
+type bucket struct {
+	tokens float64
+	seen   time.Time
+}
+
+// NewRateLimiter allows perMinute requests per key on average, with bursts of up to burst
+func NewRateLimiter(perMinute, burst int) *RateLimiter {
+	return &RateLimiter{
+		buckets:   make(map[string]*bucket),
+		rate:      float64(perMinute) / 60,
+		burst:     float64(burst),
+		lastPrune: time.Now(),
+	}
+}
+
+// Allow takes a token for key. When none is left it returns how long until the next one.
+func (l *RateLimiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastPrune) > idleBucketTTL {
+		for k, b := range l.buckets {
+			if now.Sub(b.seen) > idleBucketTTL {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, seen: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.seen).Seconds()*l.rate)
+	b.seen = now
+
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// RateLimit rejects requests over the limiter's rate with 429 and a Retry-After header.
+// key picks what is limited, e.g. ClientIP.
+func RateLimit(limiter *RateLimiter, key func(c *gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ok, wait := limiter.Allow(key(c))
+		if !ok {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many requests, try again later"})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// ClientIP limits per caller address
+func ClientIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// UserKey limits per signed-in user. Must run after AuthMiddleware.
+func UserKey(c *gin.Context) string {
+	return GetUserID(c).String()
+}
+
+// PathParam limits per path parameter, e.g. PathParam("id") for one asset
+func PathParam(name string) func(c *gin.Context) string {
+	return func(c *gin.Context) string {
+		return c.Param(name)
+	}
+}
@@ -37,9 +37,28 @@ type User struct {
 	Faculty        *string  `json:"faculty,omitempty"` // Nullable, null for Staff/Dosen
 }
 
+// Where a role change was made
+const (
+	RoleChangeSourceAdmin = "ADMIN_API"
+	RoleChangeSourceCLI   = "CLI"
+)
+
+// RoleChange is the audit trail of roles given by an admin or the bootstrap CLI
+type RoleChange struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	UserID      uuid.UUID  `gorm:"index" json:"user_id"`
+	OldRole     UserRole   `json:"old_role"` // Empty when the account was created with the role
+	NewRole     UserRole   `json:"new_role"`
+	ChangedByID *uuid.UUID `json:"changed_by_id"` // Nil for the bootstrap CLI
+	Source      string     `json:"source"`
+	Reason      string     `json:"reason"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
 type ItemCategory struct {
-	ID   uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	Name string    `json:"name"`
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Name          string    `json:"name"`
+	RetentionDays int       `json:"retention_days"` // How long unclaimed found items are kept, 0 uses ITEM_RETENTION_DAYS
 }
 
 type CampusLocation struct {
@@ -48,31 +67,80 @@ type CampusLocation struct {
 	Latitude    float64   `json:"latitude"`
 	Longitude   float64   `json:"longitude"`
 	Description string    `json:"description"`
+
+	// Staffed security post that takes found items; anonymous finders are pointed to the nearest one
+	IsSecurityDesk bool `json:"is_security_desk"`
 }
 
 type Asset struct {
 	Base
-	OwnerID         uuid.UUID    `json:"owner_id"`
-	Owner           User         `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
-	CategoryID      uuid.UUID    `json:"category_id"`
-	Category        ItemCategory `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
-	Description     string       `json:"description"`
-	PrivateImageURL string       `json:"private_image_url"` // Not shown publicly
-	LostMode        bool         `json:"lost_mode"`
-	QRCodeURL       string       `json:"qr_code_url"`
+	OwnerID         uuid.UUID          `json:"owner_id"`
+	Owner           User               `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
+	CategoryID      uuid.UUID          `json:"category_id"`
+	Category        ItemCategory       `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
+	Description     string             `json:"description"`
+	PrivateImageURL string             `json:"private_image_url"` // Not shown publicly
+	LostMode        bool               `json:"lost_mode"`
+	QRCodeURL       string             `json:"qr_code_url"`
+	QRVersion       int                `gorm:"not null;default:0" json:"-"` // Signed into the QR token, bumped to revoke printed stickers; 0 for unsigned legacy codes
+	LostEpisodes    []AssetLostEpisode `gorm:"foreignKey:AssetID" json:"lost_episodes,omitempty"`
+}
+
+// AssetLostEpisode is one period an asset spent in lost mode, with where and when it was last seen
+type AssetLostEpisode struct {
+	ID                  uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AssetID             uuid.UUID       `gorm:"index" json:"asset_id"`
+	LocationID          *uuid.UUID      `json:"location_id"` // Last seen campus location, optional
+	Location            *CampusLocation `gorm:"foreignKey:LocationID" json:"location,omitempty"`
+	LocationDescription string          `json:"location_description"` // Free text, optional
+	LostSince           time.Time       `json:"lost_since"`
+	EndedAt             *time.Time      `json:"ended_at"` // Nil while the asset is still lost
+	CreatedAt           time.Time       `json:"created_at"`
 }
 
 type FoundEvent struct {
 	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
 	AssetID    uuid.UUID      `json:"asset_id"`
 	Asset      Asset          `gorm:"foreignKey:AssetID" json:"asset,omitempty"`
-	FinderID   *uuid.UUID     `json:"finder_id"` // Can be nil if anonymous scan? No, prompt says FinderID FK. Assuming authenticated finder or security.
+	FinderID   *uuid.UUID     `json:"finder_id"` // Nil for guest reports
 	Finder     *User          `gorm:"foreignKey:FinderID" json:"finder,omitempty"`
 	LocationID uuid.UUID      `json:"location_id"`
 	Location   CampusLocation `gorm:"foreignKey:LocationID" json:"location,omitempty"`
 	Note       string         `json:"note"`
 	ImageURL   string         `json:"image_url"`
 	CreatedAt  time.Time      `json:"created_at"`
+
+	// Guest reports only: how the finder asked to be reached (e-mail, phone, @handle)
+	ContactHandle string `json:"contact_handle,omitempty"`
+	// Signed client IP, to block further reports from a reporter the owner marked as spam
+	ReporterHash string     `gorm:"index" json:"-"`
+	IsSpam       bool       `gorm:"default:false" json:"is_spam"`
+	SpamMarkedAt *time.Time `json:"spam_marked_at,omitempty"`
+}
+
+type AssetTransferStatus string
+
+const (
+	AssetTransferPending   AssetTransferStatus = "PENDING"
+	AssetTransferAccepted  AssetTransferStatus = "ACCEPTED"
+	AssetTransferDeclined  AssetTransferStatus = "DECLINED"
+	AssetTransferCancelled AssetTransferStatus = "CANCELLED"
+)
+
+// AssetTransfer hands an asset to another user, e.g. when a laptop is sold. Ownership only
+// moves once the recipient accepts; found reports and lost episodes stay with the asset.
+type AssetTransfer struct {
+	ID          uuid.UUID           `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	AssetID     uuid.UUID           `gorm:"index" json:"asset_id"`
+	Asset       Asset               `gorm:"foreignKey:AssetID" json:"-"`
+	FromUserID  uuid.UUID           `gorm:"index" json:"from_user_id"`
+	FromUser    User                `gorm:"foreignKey:FromUserID" json:"-"`
+	ToUserID    uuid.UUID           `gorm:"index" json:"to_user_id"`
+	ToUser      User                `gorm:"foreignKey:ToUserID" json:"-"`
+	Status      AssetTransferStatus `gorm:"default:'PENDING'" json:"status"`
+	Note        string              `json:"note"`
+	CreatedAt   time.Time           `json:"created_at"`
+	RespondedAt *time.Time          `json:"responded_at"` // Accepted, declined or cancelled
 }
 
 type ItemStatus string
@@ -81,8 +149,27 @@ const (
 	ItemStatusOpen     ItemStatus = "OPEN"
 	ItemStatusClaimed  ItemStatus = "CLAIMED"
 	ItemStatusResolved ItemStatus = "RESOLVED"
+	ItemStatusExpired  ItemStatus = "EXPIRED"  // Retention period ran out without a claim
+	ItemStatusArchived ItemStatus = "ARCHIVED" // Closed for good: withdrawn, disposed or filed away after return
 )
 
+// ItemStatusHistory records every status change of an item, see internal/lifecycle
+type ItemStatusHistory struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ItemID     uuid.UUID  `gorm:"index" json:"item_id"`
+	FromStatus ItemStatus `json:"from_status"`
+	ToStatus   ItemStatus `json:"to_status"`
+	Actor      string     `json:"actor"`    // FINDER, OWNER, SECURITY, CLAIM or SYSTEM
+	ActorID    *uuid.UUID `json:"actor_id"` // Nil for scheduled jobs
+	ActorUser  *User      `gorm:"foreignKey:ActorID" json:"actor_user,omitempty"`
+	Reason     string     `json:"reason"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func (ItemStatusHistory) TableName() string {
+	return "item_status_history"
+}
+
 type ItemType string
 
 const (
@@ -157,27 +244,155 @@ type Item struct {
 	Contacts            []ItemContact      `gorm:"foreignKey:ItemID" json:"contacts,omitempty"`
 	Urgency             ItemUrgency        `gorm:"default:'NORMAL'" json:"urgency"`
 	OfferReward         bool               `gorm:"default:false" json:"offer_reward"`
+	AutoApprove         bool               `gorm:"default:false" json:"auto_approve"` // Approve claims whose answers all match exactly (Found items)
+	RetentionStartedAt  *time.Time         `json:"-"`                                 // Restarted when an expired item is reopened, nil means CreatedAt
+	ExpiryRemindedAt    *time.Time         `json:"-"`                                 // When the finder was told the item is about to expire
 }
 
 type ClaimStatus string
 
 const (
-	ClaimStatusPending  ClaimStatus = "PENDING"
-	ClaimStatusApproved ClaimStatus = "APPROVED"
-	ClaimStatusRejected ClaimStatus = "REJECTED"
+	ClaimStatusPending   ClaimStatus = "PENDING"
+	ClaimStatusApproved  ClaimStatus = "APPROVED"
+	ClaimStatusRejected  ClaimStatus = "REJECTED"
+	ClaimStatusCompleted ClaimStatus = "COMPLETED" // Item handed over to the claimant
+	ClaimStatusWithdrawn ClaimStatus = "WITHDRAWN" // Taken back by the claimant
+	ClaimStatusEscalated ClaimStatus = "ESCALATED" // Rejection appealed, waiting for a SECURITY or ADMIN reviewer
 )
 
 type Claim struct {
-	ID          uuid.UUID   `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	ItemID      uuid.UUID   `json:"item_id"`
-	Item        Item        `gorm:"foreignKey:ItemID" json:"item,omitempty"`
-	OwnerID     uuid.UUID   `json:"owner_id"`
-	Owner       User        `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
-	AnswerInput string      `json:"answer_input"`
-	ImageURL    string      `json:"image_url"` // Proof image for claim
-	Status      ClaimStatus `gorm:"default:'PENDING'" json:"status"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID           uuid.UUID     `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ItemID       uuid.UUID     `json:"item_id"`
+	Item         Item          `gorm:"foreignKey:ItemID" json:"item,omitempty"`
+	OwnerID      uuid.UUID     `json:"owner_id"`
+	Owner        User          `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
+	AnswerInput  string        `json:"answer_input"`
+	ImageURL     string        `json:"image_url"` // Proof image for claim
+	Status       ClaimStatus   `gorm:"default:'PENDING'" json:"status"`
+	Reason       string        `json:"reason,omitempty"` // Why the claim was rejected, shown to the claimant
+	Answers      []ClaimAnswer `gorm:"foreignKey:ClaimID" json:"answers,omitempty"`
+	AnswerScore  float64       `json:"answer_score"`  // Average of the per-question scores, 0..1
+	AutoApproved bool          `json:"auto_approved"` // Approved on submission because every answer matched exactly
+	AppealReason string        `json:"appeal_reason,omitempty"`
+	AppealedAt   *time.Time    `json:"appealed_at,omitempty"`
+	ReviewerID   *uuid.UUID    `json:"reviewer_id,omitempty"` // SECURITY or ADMIN who decided the appeal
+	ReviewNote   string        `json:"review_note,omitempty"`
+	ReviewedAt   *time.Time    `json:"reviewed_at,omitempty"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+type AnswerMatch string
+
+const (
+	AnswerMatchExact   AnswerMatch = "EXACT"   // Same answer after normalizing case, accents and punctuation
+	AnswerMatchClose   AnswerMatch = "CLOSE"   // Typos or extra words around the right answer
+	AnswerMatchPartial AnswerMatch = "PARTIAL" // Some overlap, worth asking the claimant about
+	AnswerMatchNone    AnswerMatch = "NO_MATCH"
+)
+
+// ClaimAnswer is a claimant's answer to one verification question, scored against the
+// stored answer. Only the finder sees the score; the stored answer is never returned.
+type ClaimAnswer struct {
+	ID             uuid.UUID   `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ClaimID        uuid.UUID   `gorm:"index" json:"claim_id"`
+	VerificationID uuid.UUID   `json:"verification_id"`
+	Question       string      `json:"question"` // Copied so later edits to the question keep the claim readable
+	Answer         string      `json:"answer"`
+	Score          float64     `json:"score"`
+	Match          AnswerMatch `json:"match"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// ClaimMessage is one message in a claim's thread between the finder, the claimant and security
+type ClaimMessage struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ClaimID       uuid.UUID `gorm:"index" json:"claim_id"`
+	SenderID      uuid.UUID `json:"sender_id"`
+	Sender        User      `gorm:"foreignKey:SenderID" json:"-"`
+	Body          string    `json:"body"`
+	AttachmentURL string    `json:"attachment_url,omitempty"` // Image stored through the upload service
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// PickupCode is the one-time code an approved claimant shows at handover (numeric or as QR)
+type PickupCode struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ClaimID     uuid.UUID  `gorm:"uniqueIndex" json:"claim_id"`
+	ItemID      uuid.UUID  `gorm:"index" json:"item_id"`
+	Code        string     `json:"-"` // Only shown to the claimant
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	UsedAt      *time.Time `json:"used_at"`
+	UsedByID    *uuid.UUID `json:"used_by_id"` // Finder or security officer who entered it
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type CustodyEventType string
+
+const (
+	CustodyEventCheckIn  CustodyEventType = "CHECK_IN"
+	CustodyEventMove     CustodyEventType = "MOVE"     // New bin or shelf at the same desk
+	CustodyEventTransfer CustodyEventType = "TRANSFER" // To another desk
+	CustodyEventCheckOut CustodyEventType = "CHECK_OUT"
+	CustodyEventDispose  CustodyEventType = "DISPOSE" // Expired item leaves the desk as donated, disposed or transferred
+)
+
+type Disposition string
+
+const (
+	DispositionDonated     Disposition = "DONATED"
+	DispositionDisposed    Disposition = "DISPOSED"
+	DispositionTransferred Disposition = "TRANSFERRED" // Handed to another authority, e.g. the police
+)
+
+// Disposal is the final disposition of an expired found item, recorded by security
+type Disposal struct {
+	ID           uuid.UUID   `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	ItemID       uuid.UUID   `gorm:"uniqueIndex" json:"item_id"`
+	Item         Item        `gorm:"foreignKey:ItemID" json:"item,omitempty"`
+	Disposition  Disposition `json:"disposition"`
+	Recipient    string      `json:"recipient"` // Charity, agency or waste service, free text
+	Note         string      `json:"note"`
+	RecordedByID uuid.UUID   `json:"recorded_by_id"`
+	RecordedBy   User        `gorm:"foreignKey:RecordedByID" json:"recorded_by,omitempty"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+// Custody tracks a found item held by security: which desk and bin it is in now,
+// and who it was finally handed to
+type Custody struct {
+	Base
+	ItemID         uuid.UUID      `gorm:"index" json:"item_id"`
+	Item           Item           `gorm:"foreignKey:ItemID" json:"item,omitempty"`
+	DeskID         uuid.UUID      `gorm:"index" json:"desk_id"` // A campus location acting as security desk
+	Desk           CampusLocation `gorm:"foreignKey:DeskID" json:"desk,omitempty"`
+	StorageBin     string         `json:"storage_bin"`
+	CheckedOutAt   *time.Time     `json:"checked_out_at"` // Nil while security holds the item
+	CheckedOutToID *uuid.UUID     `json:"checked_out_to_id"`
+	CheckedOutTo   *User          `gorm:"foreignKey:CheckedOutToID" json:"checked_out_to,omitempty"`
+}
+
+// CustodyEvent is one signed step of a custody chain. Signature is an HMAC over the event
+// and the previous event's signature, so an edited or deleted event breaks the chain.
+type CustodyEvent struct {
+	ID          uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	CustodyID   uuid.UUID        `gorm:"index" json:"custody_id"`
+	Type        CustodyEventType `json:"type"`
+	DeskID      uuid.UUID        `json:"desk_id"` // Desk after the event
+	Desk        CampusLocation   `gorm:"foreignKey:DeskID" json:"desk,omitempty"`
+	FromDeskID  *uuid.UUID       `json:"from_desk_id"` // Transfers only
+	FromDesk    *CampusLocation  `gorm:"foreignKey:FromDeskID" json:"from_desk,omitempty"`
+	StorageBin  string           `json:"storage_bin"`
+	ActorID     uuid.UUID        `json:"actor_id"`
+	Actor       User             `gorm:"foreignKey:ActorID" json:"actor,omitempty"`
+	RecipientID *uuid.UUID       `json:"recipient_id"` // Check-out only
+	Recipient   *User            `gorm:"foreignKey:RecipientID" json:"recipient,omitempty"`
+	Note        string           `json:"note"`
+	Signature   string           `json:"signature"`
+	CreatedAt   time.Time        `json:"created_at"`
 }
 
 type Notification struct {
@@ -191,3 +406,86 @@ type Notification struct {
 	IsRead    bool      `gorm:"default:false" json:"is_read"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+type MatchStatus string
+
+const (
+	MatchStatusSuggested MatchStatus = "SUGGESTED"
+	MatchStatusAccepted  MatchStatus = "ACCEPTED"
+	MatchStatusDismissed MatchStatus = "DISMISSED"
+)
+
+// MatchSignal is the score (0-100) of a single matching signal.
+// Available is false when one side has no data for it (e.g. no location).
+type MatchSignal struct {
+	Score     float64 `json:"score"`
+	Available bool    `json:"available"`
+}
+
+// MatchBreakdown explains how a match was scored
+type MatchBreakdown struct {
+	Category   MatchSignal `json:"category"`
+	Location   MatchSignal `json:"location"`
+	Time       MatchSignal `json:"time"`
+	Text       MatchSignal `json:"text"`
+	DistanceM  *float64    `json:"distance_m,omitempty"`
+	TimeGapHrs *float64    `json:"time_gap_hours,omitempty"`
+	Total      float64     `json:"total"`
+}
+
+// Match links a lost report (ad-hoc item or registered asset) to a found item
+type Match struct {
+	Base
+	LostItemID  *uuid.UUID     `gorm:"index" json:"lost_item_id"`
+	LostItem    *Item          `gorm:"foreignKey:LostItemID" json:"lost_item,omitempty"`
+	LostAssetID *uuid.UUID     `gorm:"index" json:"lost_asset_id"`
+	LostAsset   *Asset         `gorm:"foreignKey:LostAssetID" json:"lost_asset,omitempty"`
+	FoundItemID uuid.UUID      `gorm:"index" json:"found_item_id"`
+	FoundItem   Item           `gorm:"foreignKey:FoundItemID" json:"found_item,omitempty"`
+	OwnerID     uuid.UUID      `gorm:"index" json:"owner_id"` // Owner of the lost report
+	FinderID    *uuid.UUID     `json:"finder_id"`
+	Score       float64        `json:"score"`
+	Breakdown   MatchBreakdown `gorm:"serializer:json;type:jsonb" json:"breakdown"`
+	Status      MatchStatus    `gorm:"default:'SUGGESTED'" json:"status"`
+	ClaimID     *uuid.UUID     `json:"claim_id"` // Set when the owner accepts
+}
+
+type JobStatus string
+
+const (
+	JobStatusPending JobStatus = "PENDING"
+	JobStatusRunning JobStatus = "RUNNING"
+	JobStatusDone    JobStatus = "DONE"
+	JobStatusDead    JobStatus = "DEAD" // Gave up after MaxAttempts, needs an admin to re-queue
+)
+
+// Job is a unit of background work in the Postgres-backed queue
+type Job struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Type        string     `gorm:"index" json:"type"`
+	Payload     string     `gorm:"type:jsonb" json:"payload"`
+	Status      JobStatus  `gorm:"index;default:'PENDING'" json:"status"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	RunAt       time.Time  `gorm:"index" json:"run_at"` // Not picked up before this time (backoff)
+	LockedAt    *time.Time `json:"locked_at"`           // Claim time, refreshed by the worker's heartbeat while it runs
+	LockedBy    *uuid.UUID `json:"locked_by"`           // Set per claim; only that claim may finish the job
+	LastError   string     `json:"last_error"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ScanChallengeUse burns the nonce of a scan page challenge once it is answered, right or
+// wrong, so each challenge allows a single guess and a single report
+type ScanChallengeUse struct {
+	Nonce     string    `gorm:"primary_key" json:"nonce"`
+	ExpiresAt time.Time `gorm:"index" json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ScheduledTask remembers when a periodic job last completed
+type ScheduledTask struct {
+	Name      string    `gorm:"primary_key" json:"name"`
+	LastRunAt time.Time `json:"last_run_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,126 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// Cursor points at the last row of the previous page: its sort value and id (tie-breaker)
+type Cursor struct {
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// Params is the shared list contract: ?limit=&cursor=&sort=
+// sort is a field name, prefixed with "-" for descending (e.g. -created_at)
+type Params struct {
+	Limit  int
+	Cursor *Cursor
+	Field  string // API field name, e.g. created_at
+	Column string // SQL column the field maps to
+	Desc   bool
+}
+
+// FromQuery parses limit, cursor and sort. allowed maps sortable API fields to SQL columns.
+func FromQuery(c *gin.Context, allowed map[string]string, defaultSort string) (Params, error) {
+	p := Params{Limit: DefaultLimit}
+
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return p, errors.New("invalid limit: must be a positive number")
+		}
+		if n > MaxLimit {
+			n = MaxLimit
+		}
+		p.Limit = n
+	}
+
+	sort := c.DefaultQuery("sort", defaultSort)
+	p.Desc = strings.HasPrefix(sort, "-")
+	p.Field = strings.TrimPrefix(sort, "-")
+	column, ok := allowed[p.Field]
+	if !ok {
+		fields := make([]string, 0, len(allowed))
+		for f := range allowed {
+			fields = append(fields, f)
+		}
+		return p, fmt.Errorf("invalid sort: must be one of %s (prefix with - for descending)", strings.Join(fields, ", "))
+	}
+	p.Column = column
+
+	if cursor := c.Query("cursor"); cursor != "" {
+		decoded, err := decodeCursor(cursor)
+		if err != nil {
+			return p, errors.New("invalid cursor")
+		}
+		p.Cursor = decoded
+	}
+
+	return p, nil
+}
+
+// Apply adds the keyset condition, ordering and limit to a query.
+// One extra row is fetched so Trim can tell whether there is a next page.
+func (p Params) Apply(query *gorm.DB) *gorm.DB {
+	return p.ApplyWithID(query, "id")
+}
+
+// ApplyWithID is Apply for queries where the id column needs a table prefix
+func (p Params) ApplyWithID(query *gorm.DB, idColumn string) *gorm.DB {
+	op, order := ">", "ASC"
+	if p.Desc {
+		op, order = "<", "DESC"
+	}
+	if p.Cursor != nil {
+		query = query.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", p.Column, idColumn, op), p.Cursor.Value, p.Cursor.ID)
+	}
+	return query.Order(p.Column + " " + order).Order(idColumn + " " + order).Limit(p.Limit + 1)
+}
+
+// Trim drops the extra row fetched by Apply and returns the cursor for the next page
+// ("" on the last page). key returns a row's sort value and id.
+func Trim[T any](rows []T, p Params, key func(T) (string, uuid.UUID)) ([]T, string) {
+	if len(rows) <= p.Limit {
+		return rows, ""
+	}
+	rows = rows[:p.Limit]
+	value, id := key(rows[len(rows)-1])
+	return rows, encodeCursor(Cursor{Value: value, ID: id})
+}
+
+// TimeValue formats a timestamp as a cursor value without losing precision
+func TimeValue(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func encodeCursor(c Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
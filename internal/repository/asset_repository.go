@@ -2,8 +2,13 @@ package repository
 
 import (
 	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
+	"errors"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type AssetRepository struct {
@@ -14,8 +19,21 @@ func NewAssetRepository(db *gorm.DB) *AssetRepository {
 	return &AssetRepository{DB: db}
 }
 
-func (r *AssetRepository) Create(asset *models.Asset) error {
-	return r.DB.Create(asset).Error
+// Create saves the asset together with the job that renders its QR code and, for an asset
+// created in lost mode, its first lost episode (nil otherwise), so a failed step cannot leave
+// an asset behind that the client will create again
+func (r *AssetRepository) Create(asset *models.Asset, episode *models.AssetLostEpisode, qrJob *models.Job) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(asset).Error; err != nil {
+			return err
+		}
+		if episode != nil {
+			if err := tx.Create(episode).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(qrJob).Error
+	})
 }
 
 func (r *AssetRepository) FindByID(id string) (*models.Asset, error) {
@@ -27,9 +45,23 @@ func (r *AssetRepository) FindByID(id string) (*models.Asset, error) {
 	return &asset, nil
 }
 
-func (r *AssetRepository) FindByOwnerID(ownerID string) ([]models.Asset, error) {
+func (r *AssetRepository) FindByOwnerID(ownerID string, page pagination.Params) ([]models.Asset, int64, error) {
+	var total int64
+	if err := r.DB.Model(&models.Asset{}).Where("owner_id = ?", ownerID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var assets []models.Asset
+	err := page.Apply(r.DB.Preload("Category").Where("owner_id = ?", ownerID)).Find(&assets).Error
+	return assets, total, err
+}
+
+func (r *AssetRepository) FindByIDs(ids []uuid.UUID) ([]models.Asset, error) {
 	var assets []models.Asset
-	err := r.DB.Preload("Category").Where("owner_id = ?", ownerID).Find(&assets).Error
+	if len(ids) == 0 {
+		return assets, nil
+	}
+	err := r.DB.Preload("Category").Where("id IN ?", ids).Find(&assets).Error
 	return assets, err
 }
 
@@ -37,18 +69,327 @@ func (r *AssetRepository) Update(asset *models.Asset) error {
 	return r.DB.Save(asset).Error
 }
 
+// Delete soft-deletes an asset. The QR version is bumped in the same transaction, so printed
+// stickers stop resolving even if the row is ever restored, and the lost episode, a pending
+// transfer and suggested matches are closed. Found reports are kept. Returns the cancelled
+// transfer, if there was one.
+func (r *AssetRepository) Delete(assetID, ownerID uuid.UUID) (*models.AssetTransfer, error) {
+	var cancelled *models.AssetTransfer
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var asset models.Asset
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&asset, "id = ?", assetID).Error; err != nil {
+			return err
+		}
+		if asset.OwnerID != ownerID {
+			return errors.New("only the owner can delete the asset")
+		}
+
+		now := time.Now()
+		if err := tx.Model(&asset).Updates(map[string]interface{}{
+			"qr_version":  gorm.Expr("qr_version + 1"),
+			"qr_code_url": "",
+			"lost_mode":   false,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.AssetLostEpisode{}).
+			Where("asset_id = ? AND ended_at IS NULL", asset.ID).Update("ended_at", now).Error; err != nil {
+			return err
+		}
+
+		var pending models.AssetTransfer
+		err := tx.Where("asset_id = ? AND status = ?", asset.ID, models.AssetTransferPending).First(&pending).Error
+		if err == nil {
+			if err := tx.Model(&pending).Updates(map[string]interface{}{"status": models.AssetTransferCancelled, "responded_at": now}).Error; err != nil {
+				return err
+			}
+			cancelled = &pending
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err := dismissSuggestedMatches(tx, asset.ID); err != nil {
+			return err
+		}
+		return tx.Delete(&asset).Error
+	})
+	return cancelled, err
+}
+
+// UpdateQRCodeURL only touches the QR column, so it cannot overwrite concurrent edits. The
+// image is only stored if it was rendered for the current QR version.
+func (r *AssetRepository) UpdateQRCodeURL(id string, version int, url string) error {
+	return r.DB.Model(&models.Asset{}).Where("id = ? AND qr_version = ?", id, version).Update("qr_code_url", url).Error
+}
+
+// RotateQR bumps the QR version, which invalidates every printed code, and clears the image
+// until the new one is rendered. Returns the new version.
+func (r *AssetRepository) RotateQR(id string) (int, error) {
+	var asset models.Asset
+	err := r.DB.Model(&asset).Clauses(clause.Returning{Columns: []clause.Column{{Name: "qr_version"}}}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"qr_version": gorm.Expr("qr_version + 1"), "qr_code_url": ""}).Error
+	return asset.QRVersion, err
+}
+
+// BackfillQRVersion sets qr_version to 0 where it was added as a nullable column, so the
+// NOT NULL constraint can be applied and the legacy codes are found by SignLegacyQRCodes.
+// Run before AutoMigrate; a fresh database has no assets table yet.
+func (r *AssetRepository) BackfillQRVersion() error {
+	if !r.DB.Migrator().HasColumn(&models.Asset{}, "qr_version") {
+		return nil
+	}
+	return r.DB.Exec("UPDATE assets SET qr_version = 0 WHERE qr_version IS NULL").Error
+}
+
+// SignLegacyQRCodes moves assets whose QR still holds a bare, unsigned scan link to version 1
+// and returns their IDs, so their codes can be rendered again
+func (r *AssetRepository) SignLegacyQRCodes() ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Asset{}).Where("qr_version = 0 OR qr_version IS NULL").Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		return tx.Model(&models.Asset{}).Where("id IN ?", ids).Updates(map[string]interface{}{"qr_version": 1, "qr_code_url": ""}).Error
+	})
+	return ids, err
+}
+
 func (r *AssetRepository) CreateFoundEvent(event *models.FoundEvent) error {
 	return r.DB.Create(event).Error
 }
 
 func (r *AssetRepository) GetFoundEvents(assetID string) ([]models.FoundEvent, error) {
 	var events []models.FoundEvent
-	err := r.DB.Preload("Location").Preload("Finder").Where("asset_id = ?", assetID).Find(&events).Error
+	err := r.DB.Preload("Location").Where("asset_id = ?", assetID).Order("created_at DESC").Find(&events).Error
 	return events, err
 }
 
+func (r *AssetRepository) FindFoundEvent(assetID, eventID string) (*models.FoundEvent, error) {
+	var event models.FoundEvent
+	err := r.DB.Preload("Asset").Where("id = ? AND asset_id = ?", eventID, assetID).First(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// MarkFoundEventSpam flags a report; it stays in the history but blocks its reporter
+func (r *AssetRepository) MarkFoundEventSpam(event *models.FoundEvent) error {
+	now := time.Now()
+	if err := r.DB.Model(event).Updates(map[string]interface{}{"is_spam": true, "spam_marked_at": now}).Error; err != nil {
+		return err
+	}
+	event.IsSpam = true
+	event.SpamMarkedAt = &now
+	return nil
+}
+
+// IsReporterBlocked reports whether the owner marked an earlier report on this asset as spam,
+// from the same account or, for guests, from the same address
+func (r *AssetRepository) IsReporterBlocked(assetID string, finderID *uuid.UUID, reporterHash string) (bool, error) {
+	query := r.DB.Model(&models.FoundEvent{}).Where("asset_id = ? AND is_spam = ?", assetID, true)
+	if finderID != nil {
+		query = query.Where("finder_id = ?", *finderID)
+	} else {
+		query = query.Where("reporter_hash = ?", reporterHash)
+	}
+	var count int64
+	err := query.Count(&count).Error
+	return count > 0, err
+}
+
+// UseScanChallenge records a challenge nonce as answered. It returns false when the nonce was
+// already used. Nonces past their expiry are pruned on the way, they fail the expiry check anyway.
+func (r *AssetRepository) UseScanChallenge(nonce string, expiresAt time.Time) (bool, error) {
+	if err := r.DB.Where("expires_at < ?", time.Now()).Delete(&models.ScanChallengeUse{}).Error; err != nil {
+		return false, err
+	}
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ScanChallengeUse{Nonce: nonce, ExpiresAt: expiresAt})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// FindLostAssets returns assets in lost mode with their open lost episode and its location
 func (r *AssetRepository) FindLostAssets() ([]models.Asset, error) {
 	var assets []models.Asset
-	err := r.DB.Where("lost_mode = ?", true).Find(&assets).Error
+	err := r.DB.Preload("Category").Preload("Owner").
+		Preload("LostEpisodes", "ended_at IS NULL").Preload("LostEpisodes.Location").
+		Where("lost_mode = ?", true).Find(&assets).Error
+	return assets, err
+}
+
+// FindLostAssetsPage is FindLostAssets for the paginated lost feed
+func (r *AssetRepository) FindLostAssetsPage(page pagination.Params) ([]models.Asset, int64, error) {
+	var total int64
+	if err := r.DB.Model(&models.Asset{}).Where("lost_mode = ?", true).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var assets []models.Asset
+	query := r.DB.Preload("Category").Preload("Owner").
+		Preload("LostEpisodes", "ended_at IS NULL").Preload("LostEpisodes.Location").
+		Where("lost_mode = ?", true)
+	err := page.Apply(query).Find(&assets).Error
+	return assets, total, err
+}
+
+// FindLostByIDs loads lost assets for a page of the merged feed, in no particular order
+func (r *AssetRepository) FindLostByIDs(ids []uuid.UUID) ([]models.Asset, error) {
+	var assets []models.Asset
+	if len(ids) == 0 {
+		return assets, nil
+	}
+	err := r.DB.Preload("Category").
+		Preload("LostEpisodes", "ended_at IS NULL").Preload("LostEpisodes.Location").
+		Where("id IN ?", ids).Find(&assets).Error
 	return assets, err
 }
+
+func (r *AssetRepository) CreateLostEpisode(episode *models.AssetLostEpisode) error {
+	return r.DB.Create(episode).Error
+}
+
+func (r *AssetRepository) UpdateLostEpisode(episode *models.AssetLostEpisode) error {
+	return r.DB.Omit("Location").Save(episode).Error
+}
+
+// FindOpenLostEpisode returns the episode of the asset's current lost mode, nil when the
+// asset is not in lost mode
+func (r *AssetRepository) FindOpenLostEpisode(assetID string) (*models.AssetLostEpisode, error) {
+	var episode models.AssetLostEpisode
+	err := r.DB.Where("asset_id = ? AND ended_at IS NULL", assetID).Order("lost_since desc").First(&episode).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &episode, nil
+}
+
+func (r *AssetRepository) GetLostEpisodes(assetID string) ([]models.AssetLostEpisode, error) {
+	var episodes []models.AssetLostEpisode
+	err := r.DB.Preload("Location").Where("asset_id = ?", assetID).Order("lost_since desc").Find(&episodes).Error
+	return episodes, err
+}
+
+// CreateTransfer offers the asset to another user. The asset row is locked, so two offers or
+// an offer racing a deletion cannot interleave.
+func (r *AssetRepository) CreateTransfer(transfer *models.AssetTransfer) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		var asset models.Asset
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&asset, "id = ?", transfer.AssetID).Error; err != nil {
+			return err
+		}
+		if asset.OwnerID != transfer.FromUserID {
+			return errors.New("only the owner can transfer the asset")
+		}
+		if asset.LostMode {
+			return errors.New("asset is in lost mode, turn it off before transferring")
+		}
+
+		var pending int64
+		if err := tx.Model(&models.AssetTransfer{}).
+			Where("asset_id = ? AND status = ?", asset.ID, models.AssetTransferPending).
+			Count(&pending).Error; err != nil {
+			return err
+		}
+		if pending > 0 {
+			return errors.New("asset already has a pending transfer")
+		}
+
+		transfer.Status = models.AssetTransferPending
+		return tx.Create(transfer).Error
+	})
+}
+
+// FindTransfer loads a transfer with both users and the asset, deleted or not
+func (r *AssetRepository) FindTransfer(id string) (*models.AssetTransfer, error) {
+	var transfer models.AssetTransfer
+	err := r.transfers().First(&transfer, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// FindTransfersByUser lists the transfers a user offered or received, newest first
+func (r *AssetRepository) FindTransfersByUser(userID uuid.UUID) ([]models.AssetTransfer, error) {
+	var transfers []models.AssetTransfer
+	err := r.transfers().Where("from_user_id = ? OR to_user_id = ?", userID, userID).Order("created_at DESC").Find(&transfers).Error
+	return transfers, err
+}
+
+func (r *AssetRepository) transfers() *gorm.DB {
+	return r.DB.Preload("Asset", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Preload("Asset.Category").Preload("FromUser").Preload("ToUser")
+}
+
+// AcceptTransfer moves the asset to the recipient. Found reports, lost episodes and the QR code
+// stay with the asset; suggested matches made for the previous owner are dismissed.
+func (r *AssetRepository) AcceptTransfer(transfer *models.AssetTransfer) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		var asset models.Asset
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&asset, "id = ?", transfer.AssetID).Error; err != nil {
+			return errors.New("asset not found")
+		}
+		if asset.OwnerID != transfer.FromUserID {
+			return errors.New("asset no longer belongs to the sender")
+		}
+		if asset.LostMode {
+			return errors.New("asset is in lost mode, the sender must turn it off first")
+		}
+
+		now := time.Now()
+		result := tx.Model(&models.AssetTransfer{}).
+			Where("id = ? AND status = ?", transfer.ID, models.AssetTransferPending).
+			Updates(map[string]interface{}{"status": models.AssetTransferAccepted, "responded_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("transfer is no longer pending")
+		}
+
+		if err := tx.Model(&asset).Update("owner_id", transfer.ToUserID).Error; err != nil {
+			return err
+		}
+		if err := dismissSuggestedMatches(tx, asset.ID); err != nil {
+			return err
+		}
+
+		transfer.Status = models.AssetTransferAccepted
+		transfer.RespondedAt = &now
+		return nil
+	})
+}
+
+// CloseTransfer declines or cancels a transfer that is still pending
+func (r *AssetRepository) CloseTransfer(transfer *models.AssetTransfer, status models.AssetTransferStatus) error {
+	now := time.Now()
+	result := r.DB.Model(&models.AssetTransfer{}).
+		Where("id = ? AND status = ?", transfer.ID, models.AssetTransferPending).
+		Updates(map[string]interface{}{"status": status, "responded_at": now})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("transfer is no longer pending")
+	}
+	transfer.Status = status
+	transfer.RespondedAt = &now
+	return nil
+}
+
+// dismissSuggestedMatches drops the matches still waiting on the asset's owner
+func dismissSuggestedMatches(tx *gorm.DB, assetID uuid.UUID) error {
+	return tx.Model(&models.Match{}).
+		Where("lost_asset_id = ? AND status = ?", assetID, models.MatchStatusSuggested).
+		Update("status", models.MatchStatusDismissed).Error
+}
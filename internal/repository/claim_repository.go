@@ -1,9 +1,15 @@
 package repository
 
 import (
+	"campus-lost-and-found/internal/lifecycle"
 	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
+	"errors"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ClaimRepository struct {
@@ -18,6 +24,103 @@ func (r *ClaimRepository) Create(claim *models.Claim) error {
 	return r.DB.Create(claim).Error
 }
 
+// CreatePending files a claim unless the user already has one pending on the item. The item
+// row is locked, so duplicate submissions and a concurrent approval cannot interleave.
+// first reports whether this is the user's first claim on the item in any status, so a
+// withdrawn claim cannot be resubmitted to guess answers again.
+func (r *ClaimRepository) CreatePending(claim *models.Claim) (first bool, err error) {
+	err = r.DB.Transaction(func(tx *gorm.DB) error {
+		var item models.Item
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&item, "id = ?", claim.ItemID).Error; err != nil {
+			return err
+		}
+		if item.Status != models.ItemStatusOpen {
+			return errors.New("item is not open for claims")
+		}
+
+		var pending int64
+		if err := tx.Model(&models.Claim{}).
+			Where("owner_id = ? AND item_id = ? AND status = ?", claim.OwnerID, claim.ItemID, models.ClaimStatusPending).
+			Count(&pending).Error; err != nil {
+			return err
+		}
+		if pending > 0 {
+			return errors.New("you already have a pending claim for this item")
+		}
+
+		var earlier int64
+		if err := tx.Unscoped().Model(&models.Claim{}).
+			Where("owner_id = ? AND item_id = ?", claim.OwnerID, claim.ItemID).
+			Count(&earlier).Error; err != nil {
+			return err
+		}
+		first = earlier == 0
+
+		claim.Status = models.ClaimStatusPending
+		return tx.Create(claim).Error
+	})
+	return first, err
+}
+
+// Approve accepts a claim that is still in the from status (PENDING, or ESCALATED on appeal)
+// and, in the same transaction, marks the item CLAIMED and rejects every other pending claim
+// on it with siblingReason. Returns the rejected claims.
+func (r *ClaimRepository) Approve(claimID uuid.UUID, from models.ClaimStatus, auto bool, siblingReason string, change lifecycle.Change) ([]models.Claim, error) {
+	var rejected []models.Claim
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var claim models.Claim
+		if err := tx.First(&claim, "id = ?", claimID).Error; err != nil {
+			return err
+		}
+
+		var item models.Item
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&item, "id = ?", claim.ItemID).Error; err != nil {
+			return err
+		}
+		if item.Status != models.ItemStatusOpen {
+			return errors.New("item is not open for claims")
+		}
+
+		result := tx.Model(&models.Claim{}).
+			Where("id = ? AND status = ?", claimID, from).
+			Updates(map[string]interface{}{"status": models.ClaimStatusApproved, "auto_approved": auto})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("claim has already been decided")
+		}
+
+		if err := transitionItem(tx, item.ID, item.Status, models.ItemStatusClaimed, change); err != nil {
+			return err
+		}
+
+		return tx.Model(&rejected).Clauses(clause.Returning{}).
+			Where("item_id = ? AND status = ? AND id <> ?", item.ID, models.ClaimStatusPending, claimID).
+			Updates(map[string]interface{}{"status": models.ClaimStatusRejected, "reason": siblingReason}).Error
+	})
+	return rejected, err
+}
+
+// EncryptAnswers converts claim answers still stored in plaintext
+func (r *ClaimRepository) EncryptAnswers(encrypt func(answer string) (string, error)) (int, error) {
+	return encryptPlaintextColumn(r.DB, "claim_answers", "answer", encrypt)
+}
+
+// Reject declines a claim that is still in the from status
+func (r *ClaimRepository) Reject(claimID uuid.UUID, from models.ClaimStatus, reason string) error {
+	result := r.DB.Model(&models.Claim{}).
+		Where("id = ? AND status = ?", claimID, from).
+		Updates(map[string]interface{}{"status": models.ClaimStatusRejected, "reason": reason})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("claim has already been decided")
+	}
+	return nil
+}
+
 func (r *ClaimRepository) FindByID(id string) (*models.Claim, error) {
 	var claim models.Claim
 	err := r.DB.Preload("Item").Preload("Owner").First(&claim, "id = ?", id).Error
@@ -29,7 +132,7 @@ func (r *ClaimRepository) FindByID(id string) (*models.Claim, error) {
 
 func (r *ClaimRepository) FindByItemID(itemID string) ([]models.Claim, error) {
 	var claims []models.Claim
-	err := r.DB.Preload("Owner").Where("item_id = ?", itemID).Find(&claims).Error
+	err := r.DB.Preload("Owner").Preload("Answers").Where("item_id = ?", itemID).Find(&claims).Error
 	return claims, err
 }
 
@@ -54,3 +157,113 @@ func (r *ClaimRepository) FindByUserAndItem(userID, itemID string) (*models.Clai
 	}
 	return &claim, nil
 }
+
+func (r *ClaimRepository) FindApprovedByItemID(itemID string) (*models.Claim, error) {
+	var claim models.Claim
+	err := r.DB.Preload("Owner").Where("item_id = ? AND status = ?", itemID, models.ClaimStatusApproved).
+		Order("updated_at desc").First(&claim).Error
+	if err != nil {
+		return nil, err
+	}
+	return &claim, nil
+}
+
+// CreateMessage saves a message and loads its sender for the response
+func (r *ClaimRepository) CreateMessage(message *models.ClaimMessage) error {
+	if err := r.DB.Create(message).Error; err != nil {
+		return err
+	}
+	return r.DB.Preload("Sender").First(message, "id = ?", message.ID).Error
+}
+
+// FindMessages returns a claim's thread, oldest first
+func (r *ClaimRepository) FindMessages(claimID string) ([]models.ClaimMessage, error) {
+	var messages []models.ClaimMessage
+	err := r.DB.Preload("Sender").Where("claim_id = ?", claimID).Order("created_at asc").Find(&messages).Error
+	return messages, err
+}
+
+// FindByOwnerID returns a claimant's own claims with their items
+func (r *ClaimRepository) FindByOwnerID(ownerID string, page pagination.Params) ([]models.Claim, int64, error) {
+	var total int64
+	if err := r.DB.Model(&models.Claim{}).Where("owner_id = ?", ownerID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var claims []models.Claim
+	err := page.Apply(r.DB.Preload("Item").Where("owner_id = ?", ownerID)).Find(&claims).Error
+	return claims, total, err
+}
+
+// HasOpenClaims reports whether an item still has claims waiting for a decision
+func (r *ClaimRepository) HasOpenClaims(itemID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.DB.Model(&models.Claim{}).
+		Where("item_id = ? AND status IN ?", itemID, []models.ClaimStatus{models.ClaimStatusPending, models.ClaimStatusEscalated}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// FindEscalated is the appeal queue for reviewers, oldest appeal first
+func (r *ClaimRepository) FindEscalated() ([]models.Claim, error) {
+	var claims []models.Claim
+	err := r.DB.Preload("Item").Preload("Owner").Preload("Answers").
+		Where("status = ?", models.ClaimStatusEscalated).Order("appealed_at asc").Find(&claims).Error
+	return claims, err
+}
+
+// Withdraw marks a claim WITHDRAWN. Withdrawing an approved claim reopens the item for
+// other claims, in the same transaction. Returns the status the claim had before.
+func (r *ClaimRepository) Withdraw(claimID uuid.UUID, change lifecycle.Change) (models.ClaimStatus, error) {
+	var previous models.ClaimStatus
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var claim models.Claim
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&claim, "id = ?", claimID).Error; err != nil {
+			return err
+		}
+		previous = claim.Status
+		switch claim.Status {
+		case models.ClaimStatusPending, models.ClaimStatusEscalated, models.ClaimStatusApproved:
+		default:
+			return errors.New("claim can no longer be withdrawn")
+		}
+
+		if err := tx.Model(&models.Claim{}).Where("id = ?", claimID).
+			Update("status", models.ClaimStatusWithdrawn).Error; err != nil {
+			return err
+		}
+
+		if claim.Status == models.ClaimStatusApproved {
+			return transitionItem(tx, claim.ItemID, models.ItemStatusClaimed, models.ItemStatusOpen, change)
+		}
+		return nil
+	})
+	return previous, err
+}
+
+// Appeal escalates a rejected claim to a reviewer. A claim can only be appealed once.
+func (r *ClaimRepository) Appeal(claimID uuid.UUID, reason string) error {
+	result := r.DB.Model(&models.Claim{}).
+		Where("id = ? AND status = ? AND appealed_at IS NULL", claimID, models.ClaimStatusRejected).
+		Updates(map[string]interface{}{
+			"status":        models.ClaimStatusEscalated,
+			"appeal_reason": reason,
+			"appealed_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("only a rejected claim can be appealed, and only once")
+	}
+	return nil
+}
+
+// RecordReview keeps who decided an appeal and why
+func (r *ClaimRepository) RecordReview(claimID uuid.UUID, reviewerID uuid.UUID, note string) error {
+	return r.DB.Model(&models.Claim{}).Where("id = ?", claimID).Updates(map[string]interface{}{
+		"reviewer_id": reviewerID,
+		"review_note": note,
+		"reviewed_at": time.Now(),
+	}).Error
+}
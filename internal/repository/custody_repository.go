@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"campus-lost-and-found/internal/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CustodyRepository struct {
+	DB *gorm.DB
+}
+
+func NewCustodyRepository(db *gorm.DB) *CustodyRepository {
+	return &CustodyRepository{DB: db}
+}
+
+// CheckIn opens a custody with its first event, unless the item is already held at a desk,
+// and marks the item as handed to security. The item row is locked so two check-ins of the
+// same item cannot both pass the check. sign receives the event once its timestamp is set
+// and returns the signature.
+func (r *CustodyRepository) CheckIn(custody *models.Custody, event *models.CustodyEvent, sign func(event *models.CustodyEvent) string) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		var item models.Item
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&item, "id = ?", custody.ItemID).Error; err != nil {
+			return err
+		}
+
+		var active int64
+		if err := tx.Model(&models.Custody{}).
+			Where("item_id = ? AND checked_out_at IS NULL", custody.ItemID).
+			Count(&active).Error; err != nil {
+			return err
+		}
+		if active > 0 {
+			return errors.New("item is already in custody")
+		}
+
+		if err := tx.Omit(clause.Associations).Create(custody).Error; err != nil {
+			return err
+		}
+
+		event.CustodyID = custody.ID
+		event.CreatedAt = eventTime()
+		event.Signature = sign(event)
+		if err := tx.Omit(clause.Associations).Create(event).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Item{}).Where("id = ?", custody.ItemID).
+			Update("return_method", models.ReturnMethodHandedToSecurity).Error
+	})
+}
+
+// AppendEvent saves the custody's new state and its next event together. The custody row is
+// locked so events chain in order; sign receives the event and the previous signature.
+func (r *CustodyRepository) AppendEvent(custody *models.Custody, event *models.CustodyEvent, sign func(event *models.CustodyEvent, prevSignature string) string) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return appendCustodyEvent(tx, custody, event, sign)
+	})
+}
+
+// appendCustodyEvent is AppendEvent inside a caller's transaction, for changes that also
+// touch the item or its claim
+func appendCustodyEvent(tx *gorm.DB, custody *models.Custody, event *models.CustodyEvent, sign func(event *models.CustodyEvent, prevSignature string) string) error {
+	var current models.Custody
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, "id = ?", custody.ID).Error; err != nil {
+		return err
+	}
+	if current.CheckedOutAt != nil {
+		return errors.New("item is no longer in custody")
+	}
+
+	prevSignature := ""
+	var last models.CustodyEvent
+	err := tx.Where("custody_id = ?", custody.ID).Order("created_at desc").First(&last).Error
+	if err == nil {
+		prevSignature = last.Signature
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if err := tx.Omit(clause.Associations).Save(custody).Error; err != nil {
+		return err
+	}
+
+	event.CustodyID = custody.ID
+	event.CreatedAt = eventTime()
+	event.Signature = sign(event, prevSignature)
+	return tx.Omit(clause.Associations).Create(event).Error
+}
+
+// FindActiveByItemID returns the custody of an item that security still holds, nil when
+// the item is not at a desk
+func (r *CustodyRepository) FindActiveByItemID(itemID string) (*models.Custody, error) {
+	var custody models.Custody
+	err := r.DB.Preload("Item").Preload("Item.Category").Preload("Desk").
+		Where("item_id = ? AND checked_out_at IS NULL", itemID).First(&custody).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &custody, nil
+}
+
+// FindLatestByItemID returns the item's most recent custody, open or checked out
+func (r *CustodyRepository) FindLatestByItemID(itemID string) (*models.Custody, error) {
+	var custody models.Custody
+	err := r.DB.Preload("Item").Preload("Item.Category").Preload("Desk").Preload("CheckedOutTo").
+		Where("item_id = ?", itemID).Order("created_at desc").First(&custody).Error
+	if err != nil {
+		return nil, err
+	}
+	return &custody, nil
+}
+
+// GetEvents returns a custody chain oldest first
+func (r *CustodyRepository) GetEvents(custodyID string) ([]models.CustodyEvent, error) {
+	var events []models.CustodyEvent
+	err := r.DB.Preload("Desk").Preload("FromDesk").Preload("Actor").Preload("Recipient").
+		Where("custody_id = ?", custodyID).Order("created_at asc").Find(&events).Error
+	return events, err
+}
+
+// FindByDesk lists the items a desk currently holds
+func (r *CustodyRepository) FindByDesk(deskID string) ([]models.Custody, error) {
+	var custodies []models.Custody
+	err := r.DB.Preload("Item").Preload("Item.Category").Preload("Desk").
+		Where("desk_id = ? AND checked_out_at IS NULL", deskID).
+		Order("storage_bin asc, created_at asc").Find(&custodies).Error
+	return custodies, err
+}
+
+// eventTime is truncated to what Postgres stores, so signatures verify after a round trip
+func eventTime() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
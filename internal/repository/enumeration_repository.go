@@ -26,8 +26,8 @@ func (r *EnumerationRepository) GetLocations() ([]models.CampusLocation, error)
 	return locations, err
 }
 
-func (r *EnumerationRepository) CreateCategory(name string) (*models.ItemCategory, error) {
-	category := &models.ItemCategory{Name: name}
+func (r *EnumerationRepository) CreateCategory(name string, retentionDays int) (*models.ItemCategory, error) {
+	category := &models.ItemCategory{Name: name, RetentionDays: retentionDays}
 	err := r.DB.Create(category).Error
 	if err != nil {
 		return nil, err
@@ -35,11 +35,32 @@ func (r *EnumerationRepository) CreateCategory(name string) (*models.ItemCategor
 	return category, nil
 }
 
-func (r *EnumerationRepository) CreateLocation(name string, lat, long float64) (*models.CampusLocation, error) {
+// UpdateCategoryRetention sets how many days unclaimed found items in a category are kept
+func (r *EnumerationRepository) UpdateCategoryRetention(id string, retentionDays int) (*models.ItemCategory, error) {
+	category, err := r.FindCategoryByID(id)
+	if err != nil {
+		return nil, err
+	}
+	category.RetentionDays = retentionDays
+	if err := r.DB.Model(category).Update("retention_days", retentionDays).Error; err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// FindSecurityDesks lists the locations staffed by security
+func (r *EnumerationRepository) FindSecurityDesks() ([]models.CampusLocation, error) {
+	var desks []models.CampusLocation
+	err := r.DB.Where("is_security_desk = ?", true).Order("name").Find(&desks).Error
+	return desks, err
+}
+
+func (r *EnumerationRepository) CreateLocation(name string, lat, long float64, isSecurityDesk bool) (*models.CampusLocation, error) {
 	location := &models.CampusLocation{
-		Name:      name,
-		Latitude:  lat,
-		Longitude: long,
+		Name:           name,
+		Latitude:       lat,
+		Longitude:      long,
+		IsSecurityDesk: isSecurityDesk,
 	}
 	err := r.DB.Create(location).Error
 	if err != nil {
@@ -90,6 +111,12 @@ func (r *EnumerationRepository) Seed() {
 		r.DB.Create(&categories)
 	}
 
+	// Default retention policy, for seeded categories that have no rule yet
+	retention := map[string]int{"Electronics": 90, "Clothing": 30, "Books": 60, "Keys": 90, "Others": 60}
+	for name, days := range retention {
+		r.DB.Model(&models.ItemCategory{}).Where("name = ? AND retention_days = 0", name).Update("retention_days", days)
+	}
+
 	r.DB.Model(&models.CampusLocation{}).Count(&count)
 	if count == 0 {
 		locations := []models.CampusLocation{
@@ -111,4 +138,15 @@ func (r *EnumerationRepository) Seed() {
 		}
 		r.DB.Create(&locations)
 	}
+
+	// Security desks, for databases seeded before locations could be marked as one
+	r.DB.Model(&models.CampusLocation{}).Where("is_security_desk = ?", true).Count(&count)
+	if count == 0 {
+		desks := []models.CampusLocation{
+			{Name: "Pos Satpam Gerbang Utama", Latitude: -7.688963, Longitude: 110.412203, Description: "Pos keamanan di gerbang utama kampus", IsSecurityDesk: true},
+			{Name: "Pos Satpam FTI", Latitude: -7.686512, Longitude: 110.411290, Description: "Pos keamanan di depan Gedung FTI", IsSecurityDesk: true},
+			{Name: "Pos Satpam Perpustakaan", Latitude: -7.688101, Longitude: 110.415502, Description: "Pos keamanan di samping Perpustakaan UII", IsSecurityDesk: true},
+		}
+		r.DB.Create(&desks)
+	}
 }
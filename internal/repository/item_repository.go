@@ -1,9 +1,18 @@
 package repository
 
 import (
+	"campus-lost-and-found/internal/lifecycle"
 	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
+	"campus-lost-and-found/internal/utils"
+	"errors"
+	"html"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ItemRepository struct {
@@ -20,38 +29,372 @@ func (r *ItemRepository) Create(item *models.Item) error {
 
 func (r *ItemRepository) FindByID(id string) (*models.Item, error) {
 	var item models.Item
-	err := r.DB.Preload("Category").Preload("Location").Preload("Finder").Preload("Owner").First(&item, "id = ?", id).Error
+	err := r.DB.Preload("Category").Preload("Location").Preload("Finder").Preload("Owner").Preload("Verifications").First(&item, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &item, nil
 }
 
-func (r *ItemRepository) FindAll(status string, itemType string) ([]models.Item, error) {
-	var items []models.Item
-	query := r.DB.Preload("Category").Preload("Location").Preload("Finder").Preload("Owner")
+// FeedRow is one entry of the merged feed: an ad-hoc item or a lost-mode asset
+type FeedRow struct {
+	Kind     string // ITEM or ASSET
+	ID       uuid.UUID
+	SortTime time.Time
+}
+
+// FindFeed pages through items and lost-mode assets together with a UNION, so sorting
+// and the cursor are applied in the database. Lost assets count as OPEN LOST entries and
+// are sorted by when they were lost. Rows only carry ids; callers load the records.
+func (r *ItemRepository) FindFeed(status string, itemType string, page pagination.Params) ([]FeedRow, int64, error) {
+	itemSQL := "SELECT 'ITEM' AS kind, id, created_at AS sort_time FROM items WHERE deleted_at IS NULL"
+	var args []interface{}
 	if status != "" {
-		query = query.Where("status = ?", status)
+		itemSQL += " AND status = ?"
+		args = append(args, status)
 	}
 	if itemType != "" {
-		query = query.Where("type = ?", itemType)
+		itemSQL += " AND type = ?"
+		args = append(args, itemType)
+	}
+
+	unionSQL := itemSQL
+	if (itemType == "" || itemType == string(models.ItemTypeLost)) && (status == "" || status == string(models.ItemStatusOpen)) {
+		unionSQL += " UNION ALL SELECT 'ASSET' AS kind, a.id, COALESCE(e.lost_since, a.updated_at) AS sort_time " +
+			"FROM assets a LEFT JOIN asset_lost_episodes e ON e.asset_id = a.id AND e.ended_at IS NULL " +
+			"WHERE a.deleted_at IS NULL AND a.lost_mode = true"
+	}
+	feed := r.DB.Raw(unionSQL, args...)
+
+	var total int64
+	if err := r.DB.Table("(?) AS feed", feed).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []FeedRow
+	err := page.Apply(r.DB.Table("(?) AS feed", feed).Select("kind, id, sort_time")).Scan(&rows).Error
+	return rows, total, err
+}
+
+// FindByIDs loads items for a page of the merged feed, in no particular order
+func (r *ItemRepository) FindByIDs(ids []uuid.UUID) ([]models.Item, error) {
+	var items []models.Item
+	if len(ids) == 0 {
+		return items, nil
 	}
-	err := query.Order("created_at desc").Find(&items).Error
+	err := r.DB.Preload("Category").Preload("Location").Preload("Finder").Preload("Owner").Preload("Contacts").
+		Where("id IN ?", ids).Find(&items).Error
 	return items, err
 }
 
-func (r *ItemRepository) FindByUserID(userID string) ([]models.Item, error) {
+// FindPublicByID loads what the public item page shows: no users, no verifications
+func (r *ItemRepository) FindPublicByID(id string) (*models.Item, error) {
+	var item models.Item
+	err := r.DB.Preload("Category").Preload("Location").Preload("Contacts").First(&item, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// FindOpenByType returns OPEN items of a type with their location, for the matching engine
+func (r *ItemRepository) FindOpenByType(itemType string) ([]models.Item, error) {
 	var items []models.Item
-	err := r.DB.Preload("Category").Preload("Location").Preload("Finder").Preload("Owner").
-		Where("owner_id = ? OR finder_id = ?", userID, userID).
-		Order("created_at desc").Find(&items).Error
+	err := r.DB.Preload("Location").
+		Where("status = ? AND type = ?", models.ItemStatusOpen, itemType).
+		Find(&items).Error
 	return items, err
 }
 
+func (r *ItemRepository) FindByUserID(userID string, page pagination.Params) ([]models.Item, int64, error) {
+	var total int64
+	if err := r.DB.Model(&models.Item{}).Where("(owner_id = ? OR finder_id = ?)", userID, userID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []models.Item
+	query := r.DB.Preload("Category").Preload("Location").Preload("Finder").Preload("Owner").
+		Where("(owner_id = ? OR finder_id = ?)", userID, userID)
+	err := page.Apply(query).Find(&items).Error
+	return items, total, err
+}
+
 func (r *ItemRepository) Update(item *models.Item) error {
 	return r.DB.Save(item).Error
 }
 
+// Transition moves an item to a new status if the state machine allows it, and records
+// the change in item_status_history
+func (r *ItemRepository) Transition(item *models.Item, to models.ItemStatus, change lifecycle.Change) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		return transitionItem(tx, item.ID, item.Status, to, change)
+	})
+	if err != nil {
+		return err
+	}
+	item.Status = to
+	return nil
+}
+
+// FindRetentionCandidates returns OPEN found items with their category, for the retention sweep
+func (r *ItemRepository) FindRetentionCandidates() ([]models.Item, error) {
+	var items []models.Item
+	err := r.DB.Preload("Category").
+		Where("status = ? AND type = ?", models.ItemStatusOpen, models.ItemTypeFound).
+		Find(&items).Error
+	return items, err
+}
+
+// FindExpired returns the disposal queue: expired found items, longest expired first
+func (r *ItemRepository) FindExpired() ([]models.Item, error) {
+	var items []models.Item
+	err := r.DB.Preload("Category").Preload("Location").Preload("Finder").
+		Where("status = ? AND type = ?", models.ItemStatusExpired, models.ItemTypeFound).
+		Order("created_at asc").
+		Find(&items).Error
+	return items, err
+}
+
+func (r *ItemRepository) MarkExpiryReminded(id uuid.UUID) error {
+	return r.DB.Model(&models.Item{}).Where("id = ?", id).Update("expiry_reminded_at", time.Now()).Error
+}
+
+// RestartRetention gives a reopened item a full retention period again
+func (r *ItemRepository) RestartRetention(id uuid.UUID) error {
+	return r.DB.Model(&models.Item{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"retention_started_at": time.Now(),
+		"expiry_reminded_at":   nil,
+	}).Error
+}
+
+// Dispose records the final disposition of an expired item and archives it, in one
+// transaction. An item held at a desk (custody not nil) is checked out with event in the
+// same transaction; sign works as in CustodyRepository.AppendEvent.
+func (r *ItemRepository) Dispose(item *models.Item, disposal *models.Disposal, change lifecycle.Change, custody *models.Custody, event *models.CustodyEvent, sign func(event *models.CustodyEvent, prevSignature string) string) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := transitionItem(tx, item.ID, item.Status, models.ItemStatusArchived, change); err != nil {
+			return err
+		}
+		disposal.ItemID = item.ID
+		if err := tx.Omit(clause.Associations).Create(disposal).Error; err != nil {
+			return err
+		}
+		if custody == nil {
+			return nil
+		}
+		return appendCustodyEvent(tx, custody, event, sign)
+	})
+	if err != nil {
+		return err
+	}
+	item.Status = models.ItemStatusArchived
+	return nil
+}
+
+// FindStatusHistory returns an item's status changes, oldest first
+func (r *ItemRepository) FindStatusHistory(itemID string) ([]models.ItemStatusHistory, error) {
+	var history []models.ItemStatusHistory
+	err := r.DB.Preload("ActorUser").Where("item_id = ?", itemID).Order("created_at asc").Find(&history).Error
+	return history, err
+}
+
+// transitionItem is the single place item statuses change. The update only applies if the
+// item is still in the from status, so concurrent changes cannot skip the state machine.
+func transitionItem(tx *gorm.DB, itemID uuid.UUID, from, to models.ItemStatus, change lifecycle.Change) error {
+	if err := lifecycle.Allow(from, to, change.Actor); err != nil {
+		return err
+	}
+
+	result := tx.Model(&models.Item{}).Where("id = ? AND status = ?", itemID, from).Update("status", to)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("item status has changed, reload and try again")
+	}
+
+	return tx.Create(&models.ItemStatusHistory{
+		ItemID:     itemID,
+		FromStatus: from,
+		ToStatus:   to,
+		Actor:      string(change.Actor),
+		ActorID:    change.ActorID,
+		Reason:     change.Reason,
+	}).Error
+}
+
 func (r *ItemRepository) Delete(id string) error {
 	return r.DB.Delete(&models.Item{}, "id = ?", id).Error
 }
+
+// searchVector builds a tsvector stemmed in both English and Indonesian
+func searchVector(weight, column string) string {
+	return "setweight(to_tsvector('english', coalesce(" + column + ", '')), '" + weight + "') || " +
+		"setweight(to_tsvector('indonesian', coalesce(" + column + ", '')), '" + weight + "')"
+}
+
+// MigrateSearch adds the generated full-text columns and their GIN indexes.
+// AutoMigrate does not know about them, so this runs after it on every start.
+func (r *ItemRepository) MigrateSearch() error {
+	statements := []string{
+		"ALTER TABLE items ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (" +
+			searchVector("A", "title") + " || " + searchVector("B", "description") + " || " + searchVector("C", "location_description") +
+			") STORED",
+		"CREATE INDEX IF NOT EXISTS idx_items_search_vector ON items USING GIN (search_vector)",
+		"ALTER TABLE assets ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (" +
+			searchVector("A", "description") +
+			") STORED",
+		"CREATE INDEX IF NOT EXISTS idx_assets_search_vector ON assets USING GIN (search_vector)",
+	}
+	for _, stmt := range statements {
+		if err := r.DB.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EncryptVerificationAnswers converts verification answers still stored in plaintext.
+// Encrypted rows are skipped, so it is safe to run on every start.
+func (r *ItemRepository) EncryptVerificationAnswers(encrypt func(answer string) (string, error)) (int, error) {
+	return encryptPlaintextColumn(r.DB, "item_verifications", "answer", encrypt)
+}
+
+// encryptPlaintextColumn encrypts a text column in place for every row that is not encrypted
+// yet, soft-deleted rows included. Returns how many rows were converted.
+func encryptPlaintextColumn(db *gorm.DB, table, column string, encrypt func(string) (string, error)) (int, error) {
+	type plaintextRow struct {
+		ID    uuid.UUID
+		Value string
+	}
+
+	converted := 0
+	var batch []plaintextRow
+	err := db.Table(table).Select("id, "+column+" AS value").
+		Where(column+" NOT LIKE ?", utils.EncryptedPrefix+"%").
+		FindInBatches(&batch, 200, func(tx *gorm.DB, _ int) error {
+			for _, row := range batch {
+				sealed, err := encrypt(row.Value)
+				if err != nil {
+					return err
+				}
+				if err := db.Table(table).Where("id = ?", row.ID).Update(column, sealed).Error; err != nil {
+					return err
+				}
+				converted++
+			}
+			return nil
+		}).Error
+	return converted, err
+}
+
+type SearchFilter struct {
+	Query      string
+	Status     string // Item status; lost-mode assets only show up for OPEN
+	CategoryID string
+	LocationID string
+	DateFrom   *time.Time
+	DateTo     *time.Time
+	Urgency    string
+	Limit      int
+}
+
+// SearchRow is one ranked hit, either an item or a lost-mode asset
+type SearchRow struct {
+	Kind       string
+	ID         uuid.UUID
+	Title      string
+	Type       string
+	Status     string
+	CategoryID uuid.UUID
+	LocationID *uuid.UUID
+	Urgency    string
+	OccurredAt time.Time
+	Rank       float64
+	Snippet    string
+}
+
+// Postgres highlights matches with these control characters instead of <mark> so the snippet
+// can be HTML-escaped before the real tags go in
+const (
+	snippetStartSel = "\x02"
+	snippetStopSel  = "\x03"
+)
+
+var snippetMarker = strings.NewReplacer(snippetStartSel, "<mark>", snippetStopSel, "</mark>")
+
+// markSnippet escapes the reported text in a ts_headline snippet and wraps matches in <mark></mark>
+func markSnippet(snippet string) string {
+	return snippetMarker.Replace(html.EscapeString(snippet))
+}
+
+// Search runs a ranked full-text search over items and lost-mode assets.
+// Assets have no urgency and are left out when that filter is set; the location
+// filter matches an asset's open lost episode.
+func (r *ItemRepository) Search(f SearchFilter) ([]SearchRow, error) {
+	const tsQuery = "(websearch_to_tsquery('english', @q) || websearch_to_tsquery('indonesian', @q))"
+	const headlineOpts = "'StartSel=" + snippetStartSel + ", StopSel=" + snippetStopSel + ", MaxFragments=2, MaxWords=20, MinWords=5'"
+	args := map[string]interface{}{"q": f.Query, "limit": f.Limit}
+
+	itemConds := []string{"i.deleted_at IS NULL", "i.search_vector @@ " + tsQuery}
+	assetConds := []string{"a.deleted_at IS NULL", "a.lost_mode = true", "a.search_vector @@ " + tsQuery}
+
+	if f.Status != "" {
+		args["status"] = f.Status
+		itemConds = append(itemConds, "i.status = @status")
+	}
+
+	if f.CategoryID != "" {
+		args["category_id"] = f.CategoryID
+		itemConds = append(itemConds, "i.category_id = @category_id")
+		assetConds = append(assetConds, "a.category_id = @category_id")
+	}
+	if f.LocationID != "" {
+		args["location_id"] = f.LocationID
+		itemConds = append(itemConds, "i.location_id = @location_id")
+		assetConds = append(assetConds, "e.location_id = @location_id")
+	}
+	if f.DateFrom != nil {
+		args["date_from"] = *f.DateFrom
+		itemConds = append(itemConds, "COALESCE(i.date_found, i.date_lost, i.created_at) >= @date_from")
+		assetConds = append(assetConds, "COALESCE(e.lost_since, a.updated_at) >= @date_from")
+	}
+	if f.DateTo != nil {
+		args["date_to"] = *f.DateTo
+		itemConds = append(itemConds, "COALESCE(i.date_found, i.date_lost, i.created_at) < @date_to")
+		assetConds = append(assetConds, "COALESCE(e.lost_since, a.updated_at) < @date_to")
+	}
+
+	itemQuery := "SELECT 'ITEM' AS kind, i.id, i.title, i.type, i.status, i.category_id, i.location_id, i.urgency, " +
+		"COALESCE(i.date_found, i.date_lost, i.created_at) AS occurred_at, " +
+		"ts_rank(i.search_vector, " + tsQuery + ") AS rank, " +
+		"ts_headline('english', concat_ws(' ', i.title, i.description, i.location_description), " + tsQuery + ", " + headlineOpts + ") AS snippet " +
+		"FROM items i WHERE " + strings.Join(itemConds, " AND ")
+
+	assetQuery := "SELECT 'ASSET' AS kind, a.id, a.description AS title, 'LOST' AS type, 'OPEN' AS status, a.category_id, e.location_id, '' AS urgency, " +
+		"COALESCE(e.lost_since, a.updated_at) AS occurred_at, " +
+		"ts_rank(a.search_vector, " + tsQuery + ") AS rank, " +
+		"ts_headline('english', a.description, " + tsQuery + ", " + headlineOpts + ") AS snippet " +
+		"FROM assets a LEFT JOIN asset_lost_episodes e ON e.asset_id = a.id AND e.ended_at IS NULL " +
+		"WHERE " + strings.Join(assetConds, " AND ")
+
+	var sql string
+	if f.Urgency != "" {
+		args["urgency"] = f.Urgency
+		sql = itemQuery + " AND i.urgency = @urgency"
+	} else if f.Status != "" && f.Status != string(models.ItemStatusOpen) {
+		sql = itemQuery
+	} else {
+		sql = itemQuery + " UNION ALL " + assetQuery
+	}
+	sql += " ORDER BY rank DESC, occurred_at DESC LIMIT @limit"
+
+	var rows []SearchRow
+	if err := r.DB.Raw(sql, args).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].Snippet = markSnippet(rows[i].Snippet)
+	}
+	return rows, nil
+}
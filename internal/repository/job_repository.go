@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"campus-lost-and-found/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JobRepository struct {
+	DB *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) *JobRepository {
+	return &JobRepository{DB: db}
+}
+
+func (r *JobRepository) Create(job *models.Job) error {
+	return r.DB.Create(job).Error
+}
+
+func (r *JobRepository) FindByID(id string) (*models.Job, error) {
+	var job models.Job
+	err := r.DB.First(&job, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ClaimNext locks the oldest due PENDING job and marks it RUNNING.
+// SKIP LOCKED lets several workers (or server instances) poll the same table.
+// Returns gorm.ErrRecordNotFound when nothing is due.
+func (r *JobRepository) ClaimNext(now time.Time) (*models.Job, error) {
+	var job models.Job
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND run_at <= ?", models.JobStatusPending, now).
+			Order("run_at").First(&job).Error
+		if err != nil {
+			return err
+		}
+
+		lock := uuid.New()
+		job.Status = models.JobStatusRunning
+		job.Attempts++
+		job.LockedAt = &now
+		job.LockedBy = &lock
+		return tx.Save(&job).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *JobRepository) Update(job *models.Job) error {
+	return r.DB.Save(job).Error
+}
+
+// Heartbeat keeps a running job's lock fresh so ResetStale leaves it alone. Returns false
+// once the job no longer belongs to this claim.
+func (r *JobRepository) Heartbeat(jobID, lock uuid.UUID, now time.Time) (bool, error) {
+	result := r.DB.Model(&models.Job{}).
+		Where("id = ? AND status = ? AND locked_by = ?", jobID, models.JobStatusRunning, lock).
+		Update("locked_at", now)
+	return result.RowsAffected > 0, result.Error
+}
+
+// Finish stores the outcome of a run, but only if the job is still RUNNING under the claim
+// that ran it. Returns false when the lock was lost and the job was handed out again.
+func (r *JobRepository) Finish(job *models.Job, lock uuid.UUID) (bool, error) {
+	result := r.DB.Model(&models.Job{}).
+		Where("id = ? AND status = ? AND locked_by = ?", job.ID, models.JobStatusRunning, lock).
+		Updates(map[string]interface{}{
+			"status":     job.Status,
+			"run_at":     job.RunAt,
+			"last_error": job.LastError,
+			"locked_at":  nil,
+			"locked_by":  nil,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ResetStale puts RUNNING jobs whose heartbeat stopped before the cutoff back to PENDING,
+// so work picked up by a server that was restarted is not lost
+func (r *JobRepository) ResetStale(cutoff time.Time) error {
+	return r.DB.Model(&models.Job{}).
+		Where("status = ? AND locked_at < ?", models.JobStatusRunning, cutoff).
+		Updates(map[string]interface{}{"status": models.JobStatusPending, "locked_at": nil, "locked_by": nil}).Error
+}
+
+// HasActive reports whether a job of this type is waiting or running
+func (r *JobRepository) HasActive(jobType string) (bool, error) {
+	var count int64
+	err := r.DB.Model(&models.Job{}).
+		Where("type = ? AND status IN ?", jobType, []models.JobStatus{models.JobStatusPending, models.JobStatusRunning}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetLastRun returns when a scheduled task last completed, zero time if never
+func (r *JobRepository) GetLastRun(name string) (time.Time, error) {
+	var task models.ScheduledTask
+	err := r.DB.Where("name = ?", name).Limit(1).Find(&task).Error
+	return task.LastRunAt, err
+}
+
+func (r *JobRepository) SetLastRun(name string, at time.Time) error {
+	return r.DB.Save(&models.ScheduledTask{Name: name, LastRunAt: at}).Error
+}
+
+func (r *JobRepository) FindByStatus(status string) ([]models.Job, error) {
+	var jobs []models.Job
+	err := r.DB.Where("status = ?", status).Order("updated_at desc").Find(&jobs).Error
+	return jobs, err
+}
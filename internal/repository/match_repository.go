@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"campus-lost-and-found/internal/models"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MatchRepository struct {
+	DB *gorm.DB
+}
+
+func NewMatchRepository(db *gorm.DB) *MatchRepository {
+	return &MatchRepository{DB: db}
+}
+
+// MigrateUniqueness adds the unique indexes CreateIfNew relies on. Duplicate pairs left
+// by earlier concurrent inserts are removed first, keeping the one that was acted on (or the oldest).
+func (r *MatchRepository) MigrateUniqueness() error {
+	statements := []string{
+		dedupeMatchesSQL("lost_item_id"),
+		dedupeMatchesSQL("lost_asset_id"),
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_matches_found_lost_item ON matches (found_item_id, lost_item_id)",
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_matches_found_lost_asset ON matches (found_item_id, lost_asset_id)",
+	}
+	for _, stmt := range statements {
+		if err := r.DB.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dedupeMatchesSQL(lostColumn string) string {
+	return "DELETE FROM matches WHERE id IN (SELECT id FROM (SELECT id, ROW_NUMBER() OVER (" +
+		"PARTITION BY found_item_id, " + lostColumn + " " +
+		"ORDER BY (status = 'SUGGESTED'), created_at, id) AS n " +
+		"FROM matches WHERE " + lostColumn + " IS NOT NULL) ranked WHERE n > 1)"
+}
+
+// CreateIfNew stores a suggestion unless the same lost/found pair already exists in any status.
+// Dismissed pairs are kept so they are never suggested again. The unique indexes make this
+// safe when the match job and the sweep score the same pair at once.
+func (r *MatchRepository) CreateIfNew(match *models.Match) (bool, error) {
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(match)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *MatchRepository) FindByID(id string) (*models.Match, error) {
+	var match models.Match
+	err := r.DB.Preload("LostItem").Preload("LostAsset").Preload("FoundItem").First(&match, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+// FindByReportID returns matches where the id is the lost item, lost asset or found item
+func (r *MatchRepository) FindByReportID(id string, status string) ([]models.Match, error) {
+	var matches []models.Match
+	query := r.DB.Preload("LostItem").Preload("LostAsset").Preload("FoundItem").
+		Where("lost_item_id = ? OR lost_asset_id = ? OR found_item_id = ?", id, id, id)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("score desc").Find(&matches).Error
+	return matches, err
+}
+
+func (r *MatchRepository) Update(match *models.Match) error {
+	return r.DB.Omit(clause.Associations).Save(match).Error
+}
+
+// Decide accepts or dismisses a suggestion, with the claim an acceptance files. Returns false
+// if the match was decided in the meantime.
+func (r *MatchRepository) Decide(id uuid.UUID, status models.MatchStatus, claimID *uuid.UUID) (bool, error) {
+	result := r.DB.Model(&models.Match{}).
+		Where("id = ? AND status = ?", id, models.MatchStatusSuggested).
+		Updates(map[string]interface{}{"status": status, "claim_id": claimID})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Reopen turns an acceptance back into a suggestion when its claim could not be filed
+func (r *MatchRepository) Reopen(id, claimID uuid.UUID) error {
+	return r.DB.Model(&models.Match{}).
+		Where("id = ? AND claim_id = ?", id, claimID).
+		Updates(map[string]interface{}{"status": models.MatchStatusSuggested, "claim_id": nil}).Error
+}
+
+// FindByClaimID returns the match an accepted claim was submitted from, nil when the
+// claim was not submitted from a match
+func (r *MatchRepository) FindByClaimID(claimID string) (*models.Match, error) {
+	var match models.Match
+	err := r.DB.Preload("LostItem").Preload("LostAsset").First(&match, "claim_id = ?", claimID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
 
 	"gorm.io/gorm"
 )
@@ -18,10 +19,15 @@ func (r *NotificationRepository) Create(notification *models.Notification) error
 	return r.DB.Create(notification).Error
 }
 
-func (r *NotificationRepository) FindByUserID(userID string) ([]models.Notification, error) {
+func (r *NotificationRepository) FindByUserID(userID string, page pagination.Params) ([]models.Notification, int64, error) {
+	var total int64
+	if err := r.DB.Model(&models.Notification{}).Where("user_id = ?", userID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
 	var notifications []models.Notification
-	err := r.DB.Where("user_id = ?", userID).Order("created_at desc").Find(&notifications).Error
-	return notifications, err
+	err := page.Apply(r.DB.Where("user_id = ?", userID)).Find(&notifications).Error
+	return notifications, total, err
 }
 
 func (r *NotificationRepository) MarkAsRead(id string) error {
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"campus-lost-and-found/internal/lifecycle"
+	"campus-lost-and-found/internal/models"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PickupRepository struct {
+	DB *gorm.DB
+}
+
+func NewPickupRepository(db *gorm.DB) *PickupRepository {
+	return &PickupRepository{DB: db}
+}
+
+// Save creates the claim's code or replaces it with a fresh one
+func (r *PickupRepository) Save(code *models.PickupCode) error {
+	return r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "claim_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"code", "attempts", "max_attempts", "expires_at", "used_at", "used_by_id", "updated_at"}),
+	}).Create(code).Error
+}
+
+func (r *PickupRepository) FindByClaimID(claimID string) (*models.PickupCode, error) {
+	var code models.PickupCode
+	err := r.DB.Where("claim_id = ?", claimID).First(&code).Error
+	if err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
+
+// FindUnusedByItemID returns the code still waiting for the item's handover
+func (r *PickupRepository) FindUnusedByItemID(itemID string) (*models.PickupCode, error) {
+	var code models.PickupCode
+	err := r.DB.Where("item_id = ? AND used_at IS NULL", itemID).Order("created_at desc").First(&code).Error
+	if err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
+
+// RecordAttempt counts one try against the code. Returns false once the retry limit is used
+// up; the check and the increment are one statement so parallel guesses cannot skip it.
+func (r *PickupRepository) RecordAttempt(id uuid.UUID) (bool, error) {
+	result := r.DB.Model(&models.PickupCode{}).
+		Where("id = ? AND attempts < max_attempts AND used_at IS NULL", id).
+		Update("attempts", gorm.Expr("attempts + 1"))
+	return result.RowsAffected == 1, result.Error
+}
+
+// Handover is everything that changes when an item reaches its claimant
+type Handover struct {
+	PickupID     *uuid.UUID // Code to consume; nil when security checked the claimant's NIM/NIP instead
+	ActorID      uuid.UUID
+	Custody      *models.Custody // Set, with CustodyEvent, when the item leaves a security desk
+	CustodyEvent *models.CustodyEvent
+	SignEvent    func(event *models.CustodyEvent, prevSignature string) string
+	Item         *models.Item
+	Claim        *models.Claim
+	Change       lifecycle.Change
+}
+
+// CompleteHandover consumes the code, checks the item out of custody, resolves the item and
+// completes the claim in one transaction, so a failed step leaves everything as it was and
+// the handover can be tried again
+func (r *PickupRepository) CompleteHandover(h Handover) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if h.PickupID != nil {
+			result := tx.Model(&models.PickupCode{}).
+				Where("id = ? AND used_at IS NULL", *h.PickupID).
+				Updates(map[string]interface{}{"used_at": time.Now(), "used_by_id": h.ActorID})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return errors.New("pickup code has already been used")
+			}
+		}
+
+		if h.Custody != nil {
+			if err := appendCustodyEvent(tx, h.Custody, h.CustodyEvent, h.SignEvent); err != nil {
+				return err
+			}
+		}
+
+		if err := transitionItem(tx, h.Item.ID, h.Item.Status, models.ItemStatusResolved, h.Change); err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.Claim{}).
+			Where("id = ? AND status = ?", h.Claim.ID, models.ClaimStatusApproved).
+			Update("status", models.ClaimStatusCompleted)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("claim is not awaiting pickup")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	h.Item.Status = models.ItemStatusResolved
+	h.Claim.Status = models.ClaimStatusCompleted
+	return nil
+}
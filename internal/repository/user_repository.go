@@ -2,6 +2,7 @@ package repository
 
 import (
 	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -37,13 +38,25 @@ func (r *UserRepository) FindByID(id uuid.UUID) (*models.User, error) {
 	return &user, nil
 }
 
-func (r *UserRepository) FindAll() ([]models.User, error) {
+// FindRole loads only the user's current role, checked on every authenticated request
+func (r *UserRepository) FindRole(id uuid.UUID) (models.UserRole, error) {
+	var user models.User
+	err := r.DB.Select("role").Where("id = ?", id).First(&user).Error
+	return user.Role, err
+}
+
+func (r *UserRepository) FindAll(page pagination.Params) ([]models.User, int64, error) {
+	var total int64
+	if err := r.DB.Model(&models.User{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
 	var users []models.User
-	err := r.DB.Find(&users).Error
+	err := page.Apply(r.DB).Find(&users).Error
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return users, nil
+	return users, total, nil
 }
 
 func (r *UserRepository) Update(user *models.User) error {
@@ -58,3 +71,30 @@ func (r *UserRepository) FindByIdentityNumber(identityNumber string) (*models.Us
 	}
 	return &user, nil
 }
+
+// CreateWithRoleChange creates an account and its first audit entry together
+func (r *UserRepository) CreateWithRoleChange(user *models.User, change *models.RoleChange) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		change.UserID = user.ID
+		return tx.Create(change).Error
+	})
+}
+
+// UpdateRole saves a new role together with its audit entry
+func (r *UserRepository) UpdateRole(user *models.User, change *models.RoleChange) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(user).Update("role", user.Role).Error; err != nil {
+			return err
+		}
+		return tx.Create(change).Error
+	})
+}
+
+func (r *UserRepository) FindRoleChanges(userID uuid.UUID) ([]models.RoleChange, error) {
+	var changes []models.RoleChange
+	err := r.DB.Where("user_id = ?", userID).Order("created_at desc").Find(&changes).Error
+	return changes, err
+}
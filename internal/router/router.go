@@ -4,6 +4,7 @@ import (
 	"campus-lost-and-found/config"
 	"campus-lost-and-found/internal/controllers"
 	"campus-lost-and-found/internal/middleware"
+	"log"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
@@ -18,6 +19,15 @@ type AppRouter struct {
 	EnumerationController  *controllers.EnumerationController
 	NotificationController *controllers.NotificationController
 	UploadController       *controllers.UploadController
+	MatchController        *controllers.MatchController
+	JobController          *controllers.JobController
+	CustodyController      *controllers.CustodyController
+	PickupController       *controllers.PickupController
+	ClaimMessageController *controllers.ClaimMessageController
+	ClaimController        *controllers.ClaimController
+	DisposalController     *controllers.DisposalController
+	PublicController       *controllers.PublicController
+	ScanController         *controllers.ScanController
 }
 
 func NewAppRouter(
@@ -28,6 +38,15 @@ func NewAppRouter(
 	enum *controllers.EnumerationController,
 	notif *controllers.NotificationController,
 	upload *controllers.UploadController,
+	match *controllers.MatchController,
+	job *controllers.JobController,
+	custody *controllers.CustodyController,
+	pickup *controllers.PickupController,
+	claimMessage *controllers.ClaimMessageController,
+	claim *controllers.ClaimController,
+	disposal *controllers.DisposalController,
+	public *controllers.PublicController,
+	scan *controllers.ScanController,
 ) *AppRouter {
 	return &AppRouter{
 		AuthController:         auth,
@@ -37,10 +56,25 @@ func NewAppRouter(
 		EnumerationController:  enum,
 		NotificationController: notif,
 		UploadController:       upload,
+		MatchController:        match,
+		JobController:          job,
+		CustodyController:      custody,
+		PickupController:       pickup,
+		ClaimMessageController: claimMessage,
+		ClaimController:        claim,
+		DisposalController:     disposal,
+		PublicController:       public,
+		ScanController:         scan,
 	}
 }
 
 func (r *AppRouter) Setup(engine *gin.Engine) {
+	// Client IPs feed the per-IP rate limits and spam blocking, so only trust
+	// X-Forwarded-For from configured proxies
+	if err := engine.SetTrustedProxies(config.AppConfig.TrustedProxies); err != nil {
+		log.Fatal("Invalid TRUSTED_PROXIES:", err)
+	}
+
 	// Swagger
 	engine.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -73,25 +107,34 @@ func (r *AppRouter) Setup(engine *gin.Engine) {
 		enum := api.Group("/enumerations")
 		{
 			enum.GET("/item-categories", r.EnumerationController.GetCategories)
-			enum.POST("/item-categories", r.EnumerationController.CreateCategory)
+			enum.POST("/item-categories", middleware.AuthMiddleware(), middleware.Require(middleware.PermManageEnumerations), r.EnumerationController.CreateCategory)
+			enum.PUT("/item-categories/:id/retention", middleware.AuthMiddleware(), middleware.Require(middleware.PermManageEnumerations), r.EnumerationController.UpdateCategoryRetention)
 			enum.GET("/campus-locations", r.EnumerationController.GetLocations)
-			enum.POST("/campus-locations", r.EnumerationController.CreateLocation)
+			enum.POST("/campus-locations", middleware.AuthMiddleware(), middleware.Require(middleware.PermManageEnumerations), r.EnumerationController.CreateLocation)
+		}
+
+		// Public Feed (no account, redacted, rate limited per IP)
+		public := api.Group("/public")
+		public.Use(middleware.RateLimit(middleware.NewRateLimiter(config.AppConfig.PublicRateLimit, config.AppConfig.PublicRateBurst), middleware.ClientIP))
+		{
+			public.GET("/items", r.PublicController.GetPublicFeed)
+			public.GET("/items/:id", r.PublicController.GetPublicItem)
+			public.GET("/items/:id/thumbnail", r.PublicController.GetPublicThumbnail)
 		}
 
-		// Public Scan
-		api.GET("/scan/:id", r.AssetController.GetAsset) // Reusing GetAsset but maybe should be specific?
-		// Prompt says: GET /scan/:asset_id -> public, return category + nearest security point.
-		// My GetAsset returns full details if owner, but partial if not?
-		// Wait, GetAsset in controller checks ownership.
-		// If not owner, it hides private image.
-		// But prompt says "return: category + nearest security point".
-		// I should probably make a specific endpoint or just let GetAsset handle it.
-		// I'll stick to GetAsset for simplicity as it already hides private data.
-		// But I need to allow public access to GetAsset?
-		// Currently GetAsset uses `middleware.GetUserID(c)` which implies it needs Auth?
-		// No, `GetUserID` returns empty if not set.
-		// But I need to make sure `GetAsset` route is NOT under AuthMiddleware.
-		// See below.
+		// Found reports. By asset ID only when signed in; guests go through the scan page, which
+		// checks the QR token and a challenge. The limiters are shared by both report routes.
+		reportsPerIP := middleware.RateLimit(middleware.NewRateLimiter(config.AppConfig.FoundReportIPRateLimit, config.AppConfig.FoundReportIPRateBurst), middleware.ClientIP)
+		reportsPerAsset := middleware.RateLimit(middleware.NewRateLimiter(config.AppConfig.FoundReportAssetRateLimit, config.AppConfig.FoundReportAssetRateBurst), middleware.PathParam("id"))
+		api.POST("/assets/:id/report-found", middleware.AuthMiddleware(), reportsPerIP, reportsPerAsset, r.AssetController.ReportFound)
+
+		// Public Scan (QR sticker landing page for finders without an account, rate limited per IP)
+		scan := api.Group("/scan")
+		scan.Use(middleware.RateLimit(middleware.NewRateLimiter(config.AppConfig.PublicRateLimit, config.AppConfig.PublicRateBurst), middleware.ClientIP))
+		{
+			scan.GET("/:id", r.ScanController.GetScan)
+			scan.POST("/:id/report-found", middleware.OptionalAuthMiddleware(), reportsPerIP, reportsPerAsset, r.ScanController.ReportScanFound)
+		}
 	}
 
 	// Protected Routes
@@ -103,30 +146,26 @@ func (r *AppRouter) Setup(engine *gin.Engine) {
 		{
 			assets.GET("/my", r.AssetController.GetUserAssets) // Get My Assets
 			assets.GET("/lost", r.AssetController.GetLostAssets) // Public/Protected? Prompt implies public feed, but here under protected. Let's keep it protected for now as per structure.
+			assets.GET("/labels", r.AssetController.GetLabels)
+			assets.GET("/transfers", r.AssetController.GetTransfers)
+			assets.PUT("/transfers/:id/accept", r.AssetController.AcceptTransfer)
+			assets.PUT("/transfers/:id/decline", r.AssetController.DeclineTransfer)
+			assets.DELETE("/transfers/:id", r.AssetController.CancelTransfer)
 			assets.POST("", r.AssetController.CreateAsset)
 			assets.GET("/:id", r.AssetController.GetAsset) // Authenticated Get
+			assets.PUT("/:id", r.AssetController.UpdateAsset)
+			assets.DELETE("/:id", r.AssetController.DeleteAsset)
+			assets.POST("/:id/transfer", r.AssetController.TransferAsset)
 			assets.PUT("/:id/lost-mode", r.AssetController.UpdateLostMode)
+			assets.POST("/:id/qr/rotate", r.AssetController.RotateQR)
+			assets.GET("/:id/lost-history", r.AssetController.GetLostHistory)
 			assets.GET("/:id/found-events", r.AssetController.GetFoundEvents)
-			assets.POST("/:id/report-found", r.AssetController.ReportFound) // This should be public?
-			// Prompt says "GET /scan/:asset_id public".
-			// "POST /assets/:asset_id/report-found creates found_event".
-			// Usually reporting found is public (anyone can scan).
-			// If so, I should move `ReportFound` to public.
-			// But `ReportFound` might need to identify the finder?
-			// Prompt says "finder_id FK".
-			// If public, finder is anonymous?
-			// Schema says "finder_id FK". FK usually implies it must exist in Users.
-			// So Finder MUST be logged in?
-			// "Role Guard (ONLY finder can accept claims)".
-			// "items (Finder-First) ... finder_id FK".
-			// "found_events ... finder_id FK".
-			// So yes, Finder must be logged in to report?
-			// Or maybe there is a "Security" role that scans?
-			// Let's assume for `ReportFound` (Scan Flow), the user must be logged in.
-			// But `GET /scan/:id` is public.
+			assets.POST("/:id/found-events/:event_id/reply", r.AssetController.ReplyToFoundEvent)
+			assets.PUT("/:id/found-events/:event_id/spam", r.AssetController.MarkFoundEventSpam)
 		}
 
 		// Items (Finder First)
+		claimsPerUser := middleware.RateLimit(middleware.NewRateLimiter(config.AppConfig.ClaimRateLimit, config.AppConfig.ClaimRateBurst), middleware.UserKey)
 		items := protected.Group("/items")
 		{
 			items.POST("/lost", r.ItemController.ReportLostItem) // Ad-Hoc Lost Item
@@ -136,15 +175,55 @@ func (r *AppRouter) Setup(engine *gin.Engine) {
 			items.GET("/:id", r.ItemController.GetItem)
 			items.PUT("/:id", r.ItemController.UpdateItem) // Edit Item
 			items.PUT("/:id/status", r.ItemController.UpdateItemStatus) // Update Status
+			items.GET("/:id/history", r.ItemController.GetItemHistory)
 			items.DELETE("/:id", r.ItemController.DeleteItem)
-			items.POST("/:id/claim", r.ItemController.SubmitClaim)
+			items.POST("/:id/claim", claimsPerUser, r.ItemController.SubmitClaim)
 			items.GET("/:id/claims", r.ItemController.GetClaims)
+			items.GET("/:id/matches", r.MatchController.GetItemMatches)
+			items.POST("/:id/handover", r.PickupController.Handover)
+		}
+
+		// Matches
+		matches := protected.Group("/matches")
+		{
+			matches.PUT("/:id", r.MatchController.UpdateMatch)
 		}
 
+		// Search
+		protected.GET("/search", r.ItemController.Search)
+
 		// Claims
 		claims := protected.Group("/claims")
 		{
+			claims.GET("/my", r.ClaimController.GetMyClaims)
+			claims.GET("/escalated", middleware.Require(middleware.PermReviewClaims), r.ClaimController.GetEscalatedClaims)
+			claims.DELETE("/:id", r.ClaimController.WithdrawClaim)
+			claims.POST("/:id/appeal", r.ClaimController.AppealClaim)
+			claims.PUT("/:id/review", middleware.Require(middleware.PermReviewClaims), r.ClaimController.ReviewClaim)
 			claims.PUT("/:id/decide", r.ItemController.DecideClaim)
+			claims.GET("/:id/pickup-code", r.PickupController.GetPickupCode)
+			claims.POST("/:id/pickup-code", r.PickupController.RegeneratePickupCode)
+			claims.GET("/:id/messages", r.ClaimMessageController.GetMessages)
+			claims.POST("/:id/messages", r.ClaimMessageController.PostMessage)
+		}
+
+		// Security Desk Custody
+		custody := protected.Group("/custody")
+		custody.Use(middleware.Require(middleware.PermManageCustody))
+		{
+			custody.POST("/check-in", r.CustodyController.CheckIn)
+			custody.GET("/items/:id", r.CustodyController.GetCustody)
+			custody.POST("/items/:id/transfer", r.CustodyController.TransferCustody)
+			custody.POST("/items/:id/check-out", r.CustodyController.CheckOut)
+			custody.GET("/desks/:id/inventory", r.CustodyController.GetDeskInventory)
+		}
+
+		// Disposal of expired items
+		disposals := protected.Group("/disposals")
+		disposals.Use(middleware.Require(middleware.PermManageDisposals))
+		{
+			disposals.GET("", r.DisposalController.GetDisposalQueue)
+			disposals.POST("", r.DisposalController.DisposeItem)
 		}
 
 		// Notifications
@@ -160,13 +239,19 @@ func (r *AppRouter) Setup(engine *gin.Engine) {
 		// Users
 		users := protected.Group("/users")
 		{
-			users.GET("", r.UserController.GetAllUsers)
+			users.GET("", middleware.Require(middleware.PermListUsers), r.UserController.GetAllUsers)
 			users.GET("/:id", r.UserController.GetUser)
 			users.PUT("/me", r.UserController.UpdateUser)
 		}
-	}
-
-	// Public Scan Endpoint (Specific)
-	// Already registered above in line 59
 
+		// Admin
+		admin := protected.Group("/admin")
+		{
+			admin.GET("/jobs/failed", middleware.Require(middleware.PermManageJobs), r.JobController.GetFailedJobs)
+			admin.POST("/jobs/:id/requeue", middleware.Require(middleware.PermManageJobs), r.JobController.RequeueJob)
+			admin.POST("/users", middleware.Require(middleware.PermManageUsers), r.UserController.CreateUser)
+			admin.PUT("/users/:id/role", middleware.Require(middleware.PermManageUsers), r.UserController.ChangeRole)
+			admin.GET("/users/:id/role-changes", middleware.Require(middleware.PermManageUsers), r.UserController.GetRoleChanges)
+		}
+	}
 }
@@ -1,10 +1,21 @@
 package services
 
 import (
+	"campus-lost-and-found/config"
 	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/jobs"
+	"campus-lost-and-found/internal/labels"
 	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
 	"campus-lost-and-found/internal/repository"
+	"campus-lost-and-found/internal/utils"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/skip2/go-qrcode"
@@ -12,141 +23,667 @@ import (
 
 type AssetService struct {
 	Repo          *repository.AssetRepository
+	EnumRepo      *repository.EnumerationRepository
+	UserRepo      *repository.UserRepository
 	UploadService *UploadService
 	NotifService  *NotificationService
+	Jobs          *jobs.Runner
 }
 
-func NewAssetService(repo *repository.AssetRepository, uploadService *UploadService, notifService *NotificationService) *AssetService {
+func NewAssetService(repo *repository.AssetRepository, enumRepo *repository.EnumerationRepository, userRepo *repository.UserRepository, uploadService *UploadService, notifService *NotificationService, jobRunner *jobs.Runner) *AssetService {
 	return &AssetService{
 		Repo:          repo,
+		EnumRepo:      enumRepo,
+		UserRepo:      userRepo,
 		UploadService: uploadService,
 		NotifService:  notifService,
+		Jobs:          jobRunner,
 	}
 }
 
 func (s *AssetService) CreateAsset(req dto.CreateAssetRequest, ownerID uuid.UUID) (*dto.AssetResponse, error) {
 	asset := &models.Asset{
+		Base:            models.Base{ID: uuid.New()},
 		OwnerID:         ownerID,
 		CategoryID:      req.CategoryID,
 		Description:     req.Description,
 		PrivateImageURL: req.PrivateImageURL,
 		LostMode:        req.LostMode,
+		QRVersion:       1,
 	}
 
-	if err := s.Repo.Create(asset); err != nil {
-		return nil, err
+	// Created straight into lost mode: open an episode without location context
+	var episode *models.AssetLostEpisode
+	if asset.LostMode {
+		episode = &models.AssetLostEpisode{
+			AssetID:   asset.ID,
+			LostSince: time.Now(),
+		}
 	}
 
-	// Generate QR Code
-	qrContent := fmt.Sprintf("https://campuslf.afsar.my.id/scan/%s", asset.ID.String())
-	png, err := qrcode.Encode(qrContent, qrcode.Medium, 256)
+	// Generate QR Code in the background, QRCodeURL is filled in once the job has run
+	qrJob, err := s.Jobs.NewJob(jobs.TypeGenerateQR, jobs.AssetPayload{AssetID: asset.ID})
 	if err != nil {
 		return nil, err
 	}
+	if err := s.Repo.Create(asset, episode, qrJob); err != nil {
+		return nil, err
+	}
+
+	return &dto.AssetResponse{
+		ID:              asset.ID,
+		OwnerID:         asset.OwnerID,
+		CategoryID:      asset.CategoryID,
+		Description:     asset.Description,
+		PrivateImageURL: asset.PrivateImageURL,
+		LostMode:        asset.LostMode,
+		QRCodeURL:       asset.QRCodeURL,
+		ScanURL:         ScanURL(asset),
+		CreatedAt:       asset.CreatedAt,
+	}, nil
+}
 
-	// Upload QR Code
-	filename := fmt.Sprintf("qr_%s.png", asset.ID.String())
+// HandleGenerateQR is the job handler that renders and uploads an asset's QR code
+func (s *AssetService) HandleGenerateQR(payload []byte) error {
+	var p jobs.AssetPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return err
+	}
+
+	asset, err := s.Repo.FindByID(p.AssetID.String())
+	if err != nil {
+		return err
+	}
+
+	png, err := qrcode.Encode(ScanURL(asset), qrcode.Medium, 256)
+	if err != nil {
+		return err
+	}
+
+	// Uploads are served statically, so the name is signed rather than derived from the asset ID
+	// alone; one file per version, so a rotated code never shows up under the old image URL
+	filename := fmt.Sprintf("qr_%s.png", utils.Sign(fmt.Sprintf("ASSET_QR_IMAGE|%s|%d", asset.ID.String(), asset.QRVersion))[:32])
 	qrURL, err := s.UploadService.UploadBytes(png, filename)
+	if err != nil {
+		return err
+	}
+
+	return s.Repo.UpdateQRCodeURL(asset.ID.String(), asset.QRVersion, qrURL)
+}
+
+// RotateQR revokes every printed QR code of an asset and renders a new one (owner only)
+func (s *AssetService) RotateQR(id string, userID uuid.UUID) error {
+	asset, err := s.Repo.FindByID(id)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if asset.OwnerID != userID {
+		return errors.New("only the owner can rotate the QR code")
+	}
+
+	if _, err := s.Repo.RotateQR(asset.ID.String()); err != nil {
+		return err
+	}
+	return s.Jobs.Enqueue(jobs.TypeGenerateQR, jobs.AssetPayload{AssetID: asset.ID})
+}
+
+// SignLegacyQRCodes re-renders the QR codes created before scan links were signed. Stickers
+// printed from those codes stop working and have to be printed again.
+func (s *AssetService) SignLegacyQRCodes() (int, error) {
+	ids, err := s.Repo.SignLegacyQRCodes()
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		if err := s.Jobs.Enqueue(jobs.TypeGenerateQR, jobs.AssetPayload{AssetID: id}); err != nil {
+			return 0, err
+		}
+	}
+	return len(ids), nil
+}
+
+// Label sheet limits and the text printed next to each code
+const (
+	maxLabelsPerRequest = 200
+	labelMessage        = "If found, scan me to reach my owner"
+)
+
+// RenderLabels renders print-ready sticker sheets with the QR codes of the owner's assets, one
+// label per ID in the order given (repeat an ID for several stickers). format is pdf or svg,
+// ecc the QR error correction level L, M, Q or H.
+func (s *AssetService) RenderLabels(ids []string, layoutName, format, ecc string, userID uuid.UUID) ([]byte, error) {
+	layout, err := labels.FindLayout(layoutName)
 	if err != nil {
 		return nil, err
 	}
+	level, ok := labels.ErrorCorrection[ecc]
+	if !ok {
+		return nil, errors.New("invalid ecc: must be L, M, Q or H")
+	}
+	if format != "pdf" && format != "svg" {
+		return nil, errors.New("invalid format: must be pdf or svg")
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("ids is required")
+	}
+	if len(ids) > maxLabelsPerRequest {
+		return nil, fmt.Errorf("at most %d labels per request", maxLabelsPerRequest)
+	}
+
+	assetIDs := make([]uuid.UUID, len(ids))
+	for i, id := range ids {
+		assetIDs[i], err = uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid asset id: %s", id)
+		}
+	}
+	assets, err := s.Repo.FindByIDs(assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*models.Asset, len(assets))
+	for i := range assets {
+		byID[assets[i].ID] = &assets[i]
+	}
+
+	items := make([]labels.Label, len(assetIDs))
+	for i, id := range assetIDs {
+		asset, ok := byID[id]
+		if !ok {
+			return nil, errors.New("asset not found")
+		}
+		if asset.OwnerID != userID {
+			return nil, errors.New("only the owner can print labels for an asset")
+		}
+		title := asset.Category.Name
+		if title == "" {
+			title = "Campus Lost & Found"
+		}
+		items[i] = labels.Label{Content: ScanURL(asset), Title: title, Message: labelMessage}
+	}
 
-	asset.QRCodeURL = qrURL
-	s.Repo.Update(asset)
+	if format == "svg" {
+		return labels.RenderSVG(layout, items, level)
+	}
+	return labels.RenderPDF(layout, items, level)
+}
+
+// ScanURL is the link printed in an asset's QR code: the scan page with a token signed over
+// the asset and its QR version
+func ScanURL(asset *models.Asset) string {
+	return fmt.Sprintf("%s/%s?t=%s", config.AppConfig.ScanBaseURL, asset.ID.String(), assetQRToken(asset.ID, asset.QRVersion))
+}
+
+// assetQRToken is "<version>.<signature>", the signature shortened to keep the QR small
+func assetQRToken(assetID uuid.UUID, version int) string {
+	return fmt.Sprintf("%d.%s", version, utils.Sign(fmt.Sprintf("ASSET_QR|%s|%d", assetID.String(), version))[:32])
+}
+
+// verifyAssetQRToken checks a scanned token. A forged or missing token reads as an unknown
+// asset, so scan links cannot be guessed; a genuine token from a rotated code is reported as such.
+func verifyAssetQRToken(asset *models.Asset, token string) error {
+	parts := strings.SplitN(token, ".", 2)
+	version, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts) != 2 || version < 1 {
+		return errors.New("asset not found")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(assetQRToken(asset.ID, version))) != 1 {
+		return errors.New("asset not found")
+	}
+	if version != asset.QRVersion {
+		return errors.New("this QR code has been revoked by the owner")
+	}
+	return nil
+}
+
+func (s *AssetService) GetAsset(id string) (*models.Asset, error) {
+	return s.Repo.FindByID(id)
+}
+
+// UpdateAsset edits the category, description or private image (owner only)
+func (s *AssetService) UpdateAsset(id string, req dto.UpdateAssetRequest, userID uuid.UUID) (*dto.AssetResponse, error) {
+	asset, err := s.Repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if asset.OwnerID != userID {
+		return nil, errors.New("only the owner can edit the asset")
+	}
+
+	if req.CategoryID != nil {
+		category, err := s.EnumRepo.FindCategoryByID(req.CategoryID.String())
+		if err != nil {
+			return nil, errors.New("invalid category_id: category does not exist")
+		}
+		asset.CategoryID = category.ID
+		asset.Category = *category
+	}
+	if description := strings.TrimSpace(req.Description); description != "" {
+		asset.Description = description
+	}
+	if req.PrivateImageURL != "" {
+		asset.PrivateImageURL = req.PrivateImageURL
+	}
+
+	if err := s.Repo.Update(asset); err != nil {
+		return nil, err
+	}
 
 	return &dto.AssetResponse{
 		ID:              asset.ID,
 		OwnerID:         asset.OwnerID,
 		CategoryID:      asset.CategoryID,
+		CategoryName:    asset.Category.Name,
 		Description:     asset.Description,
 		PrivateImageURL: asset.PrivateImageURL,
 		LostMode:        asset.LostMode,
 		QRCodeURL:       asset.QRCodeURL,
+		ScanURL:         ScanURL(asset),
 		CreatedAt:       asset.CreatedAt,
 	}, nil
 }
 
-func (s *AssetService) GetAsset(id string) (*models.Asset, error) {
-	return s.Repo.FindByID(id)
+// DeleteAsset removes an asset and revokes its QR code; scanning a printed sticker then
+// reports the asset as not found. A pending transfer is cancelled (owner only).
+func (s *AssetService) DeleteAsset(id string, userID uuid.UUID) error {
+	asset, err := s.Repo.FindByID(id)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if asset.OwnerID != userID {
+		return errors.New("only the owner can delete the asset")
+	}
+
+	cancelled, err := s.Repo.Delete(asset.ID, userID)
+	if err != nil {
+		return err
+	}
+	if cancelled != nil {
+		s.NotifService.CreateNotification(
+			cancelled.ToUserID,
+			"Asset Transfer Cancelled",
+			fmt.Sprintf("The asset '%s' offered to you has been deleted by its owner.", asset.Description),
+			"ASSET_TRANSFER",
+			cancelled.ID,
+		)
+	}
+	return nil
 }
 
-func (s *AssetService) UpdateLostMode(id string, lostMode bool, userID uuid.UUID) error {
+// UpdateLostMode toggles lost mode. Turning it on opens a lost episode with the last-seen
+// context (or updates the open one if the asset is already lost); turning it off closes it.
+func (s *AssetService) UpdateLostMode(id string, req dto.UpdateLostModeRequest, userID uuid.UUID) error {
 	asset, err := s.Repo.FindByID(id)
 	if err != nil {
 		return err
 	}
 
 	if asset.OwnerID != userID {
-		return fmt.Errorf("unauthorized")
+		return errors.New("only the owner can change lost mode")
+	}
+
+	openEpisode, err := s.Repo.FindOpenLostEpisode(asset.ID.String())
+	if err != nil {
+		return err
 	}
 
-	asset.LostMode = lostMode
+	if !req.LostMode {
+		if openEpisode != nil {
+			now := time.Now()
+			openEpisode.EndedAt = &now
+			if err := s.Repo.UpdateLostEpisode(openEpisode); err != nil {
+				return err
+			}
+		}
+		asset.LostMode = false
+		return s.Repo.Update(asset)
+	}
+
+	if req.LastSeenLocationID != nil {
+		if _, err := s.EnumRepo.FindLocationByID(req.LastSeenLocationID.String()); err != nil {
+			return errors.New("invalid last_seen_location_id: location does not exist")
+		}
+	}
+
+	lostSince := time.Now()
+	if req.LostSince != "" {
+		lostSince, err = parseDateOrTime(req.LostSince)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Fields left out of the request keep what the open episode already has
+	episode := openEpisode
+	if episode == nil {
+		episode = &models.AssetLostEpisode{AssetID: asset.ID}
+	}
+	if req.LastSeenLocationID != nil {
+		episode.LocationID = req.LastSeenLocationID
+	}
+	if req.LastSeenDescription != "" {
+		episode.LocationDescription = req.LastSeenDescription
+	}
+	if openEpisode == nil || req.LostSince != "" {
+		episode.LostSince = lostSince
+	}
+
+	if openEpisode == nil {
+		err = s.Repo.CreateLostEpisode(episode)
+	} else {
+		err = s.Repo.UpdateLostEpisode(episode)
+	}
+	if err != nil {
+		return err
+	}
+
+	asset.LostMode = true
 	return s.Repo.Update(asset)
 }
 
-func (s *AssetService) ReportFound(assetID string, req dto.ReportFoundRequest) error {
+// GetLostHistory returns every lost-mode episode of an asset (owner only)
+func (s *AssetService) GetLostHistory(id string, userID uuid.UUID) ([]models.AssetLostEpisode, error) {
+	asset, err := s.Repo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if asset.OwnerID != userID {
+		return nil, fmt.Errorf("unauthorized")
+	}
+
+	return s.Repo.GetLostEpisodes(asset.ID.String())
+}
+
+// parseDateOrTime accepts YYYY-MM-DD or RFC3339
+func parseDateOrTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errors.New("invalid date format, use YYYY-MM-DD or RFC3339")
+	}
+	return t, nil
+}
+
+// ReportFound records where an asset was found. finderID is the signed-in finder, or nil for a
+// guest, who may leave a contact handle instead. The owner's notification points at the report,
+// through which owner and finder reply to each other without sharing contact details.
+func (s *AssetService) ReportFound(assetID string, req dto.ReportFoundRequest, finderID *uuid.UUID, clientIP string) error {
 	asset, err := s.Repo.FindByID(assetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	location, err := s.EnumRepo.FindLocationByID(req.LocationID.String())
+	if err != nil {
+		return errors.New("invalid location_id: location does not exist")
+	}
+
+	reporterHash := utils.Sign("FOUND_REPORTER|" + clientIP)
+	blocked, err := s.Repo.IsReporterBlocked(asset.ID.String(), finderID, reporterHash)
 	if err != nil {
 		return err
 	}
+	if blocked {
+		return errors.New("your reports for this asset have been marked as spam")
+	}
 
 	event := &models.FoundEvent{
-		AssetID:    asset.ID,
-		LocationID: req.LocationID,
-		Note:       req.Note,
-		ImageURL:   req.ImageURL,
+		AssetID:      asset.ID,
+		FinderID:     finderID,
+		LocationID:   location.ID,
+		Note:         req.Note,
+		ImageURL:     req.ImageURL,
+		ReporterHash: reporterHash,
+	}
+	if finderID == nil {
+		event.ContactHandle = strings.TrimSpace(req.ContactHandle)
 	}
 
 	if err := s.Repo.CreateFoundEvent(event); err != nil {
 		return err
 	}
 
-	// Notify Owner
+	body := fmt.Sprintf("Your asset '%s' was reported found at %s.", asset.Description, location.Name)
+	switch {
+	case finderID != nil:
+		body += " Open the report to reply to the finder; neither of you sees the other's contact details."
+	case event.ContactHandle != "":
+		body += fmt.Sprintf(" The finder left a contact: %s.", event.ContactHandle)
+	default:
+		body += " The finder left no contact, ask at the nearest security desk."
+	}
+	s.NotifService.CreateNotification(asset.OwnerID, "Asset Scanned!", body, "ASSET_FOUND", event.ID)
+
+	return nil
+}
+
+// GetFoundEvents returns the reports on an asset, newest first (owner only)
+func (s *AssetService) GetFoundEvents(assetID string, userID uuid.UUID) ([]models.FoundEvent, error) {
+	asset, err := s.Repo.FindByID(assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if asset.OwnerID != userID {
+		return nil, errors.New("only the owner can view found reports")
+	}
+	return s.Repo.GetFoundEvents(asset.ID.String())
+}
+
+// ReplyToFoundEvent relays a message between the owner and a signed-in finder as a notification
+func (s *AssetService) ReplyToFoundEvent(assetID, eventID string, req dto.FoundEventReplyRequest, userID uuid.UUID) error {
+	event, err := s.Repo.FindFoundEvent(assetID, eventID)
+	if err != nil {
+		return errors.New("found report not found")
+	}
+	if event.IsSpam {
+		return errors.New("report has been marked as spam")
+	}
+
+	isOwner := event.Asset.OwnerID == userID
+	isFinder := event.FinderID != nil && *event.FinderID == userID
+	if !isOwner && !isFinder {
+		return errors.New("only the owner and the finder can reply to a found report")
+	}
+
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		return errors.New("message cannot be empty")
+	}
+	body := fmt.Sprintf("About '%s': %s", event.Asset.Description, message)
+
+	if isOwner {
+		if event.FinderID == nil {
+			return errors.New("the finder reported as a guest, use the contact they left")
+		}
+		return s.NotifService.CreateNotification(*event.FinderID, "Message From the Owner", body, "FOUND_EVENT_REPLY", event.ID)
+	}
+	return s.NotifService.CreateNotification(event.Asset.OwnerID, "Message From the Finder", body, "FOUND_EVENT_REPLY", event.ID)
+}
+
+// MarkFoundEventSpam flags a report as spam; its reporter cannot report this asset again (owner only)
+func (s *AssetService) MarkFoundEventSpam(assetID, eventID string, userID uuid.UUID) error {
+	event, err := s.Repo.FindFoundEvent(assetID, eventID)
+	if err != nil {
+		return errors.New("found report not found")
+	}
+	if event.Asset.OwnerID != userID {
+		return errors.New("only the owner can mark a report as spam")
+	}
+	if event.IsSpam {
+		return errors.New("report is already marked as spam")
+	}
+	return s.Repo.MarkFoundEventSpam(event)
+}
+
+// TransferAsset offers an asset to another account, e.g. after selling it. The owner keeps it
+// until the recipient accepts (owner only).
+func (s *AssetService) TransferAsset(id string, req dto.TransferAssetRequest, userID uuid.UUID) (*dto.AssetTransferResponse, error) {
+	asset, err := s.Repo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if asset.OwnerID != userID {
+		return nil, errors.New("only the owner can transfer the asset")
+	}
+
+	recipient, err := s.UserRepo.FindByEmail(strings.TrimSpace(req.RecipientEmail))
+	if err != nil {
+		return nil, errors.New("recipient not found")
+	}
+	if recipient.ID == userID {
+		return nil, errors.New("you already own this asset")
+	}
+
+	transfer := &models.AssetTransfer{
+		AssetID:    asset.ID,
+		FromUserID: userID,
+		ToUserID:   recipient.ID,
+		Note:       strings.TrimSpace(req.Note),
+	}
+	if err := s.Repo.CreateTransfer(transfer); err != nil {
+		return nil, err
+	}
+
 	s.NotifService.CreateNotification(
-		asset.OwnerID,
-		"Asset Scanned!",
-		fmt.Sprintf("Your asset '%s' was scanned at a location.", asset.Description),
-		"ASSET_FOUND",
-		event.ID,
+		recipient.ID,
+		"Asset Transfer Offered",
+		fmt.Sprintf("%s wants to transfer the asset '%s' to you. Accept it to become its owner.", asset.Owner.Name, asset.Description),
+		"ASSET_TRANSFER",
+		transfer.ID,
 	)
 
-	return nil
+	transfer.Asset = *asset
+	transfer.FromUser = asset.Owner
+	transfer.ToUser = *recipient
+	return toAssetTransferResponse(transfer, userID), nil
+}
+
+// GetTransfers lists the transfers the user offered or received, newest first
+func (s *AssetService) GetTransfers(userID uuid.UUID) ([]dto.AssetTransferResponse, error) {
+	transfers, err := s.Repo.FindTransfersByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := []dto.AssetTransferResponse{}
+	for _, transfer := range transfers {
+		responses = append(responses, *toAssetTransferResponse(&transfer, userID))
+	}
+	return responses, nil
+}
+
+// AcceptTransfer makes the recipient the owner. The asset keeps its found reports, lost
+// history and QR code, so stickers already on it keep working (recipient only).
+func (s *AssetService) AcceptTransfer(id string, userID uuid.UUID) (*dto.AssetTransferResponse, error) {
+	transfer, err := s.Repo.FindTransfer(id)
+	if err != nil {
+		return nil, errors.New("transfer not found")
+	}
+	if transfer.ToUserID != userID {
+		return nil, errors.New("only the recipient can accept the transfer")
+	}
+
+	if err := s.Repo.AcceptTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	s.NotifService.CreateNotification(
+		transfer.FromUserID,
+		"Asset Transfer Accepted",
+		fmt.Sprintf("%s accepted the asset '%s'. It no longer appears in your assets.", transfer.ToUser.Name, transfer.Asset.Description),
+		"ASSET_TRANSFER",
+		transfer.ID,
+	)
+	return toAssetTransferResponse(transfer, userID), nil
+}
+
+// DeclineTransfer turns an offered asset down; the sender keeps it (recipient only)
+func (s *AssetService) DeclineTransfer(id string, userID uuid.UUID) (*dto.AssetTransferResponse, error) {
+	transfer, err := s.Repo.FindTransfer(id)
+	if err != nil {
+		return nil, errors.New("transfer not found")
+	}
+	if transfer.ToUserID != userID {
+		return nil, errors.New("only the recipient can decline the transfer")
+	}
+
+	if err := s.Repo.CloseTransfer(transfer, models.AssetTransferDeclined); err != nil {
+		return nil, err
+	}
+
+	s.NotifService.CreateNotification(
+		transfer.FromUserID,
+		"Asset Transfer Declined",
+		fmt.Sprintf("%s declined the asset '%s'. You are still its owner.", transfer.ToUser.Name, transfer.Asset.Description),
+		"ASSET_TRANSFER",
+		transfer.ID,
+	)
+	return toAssetTransferResponse(transfer, userID), nil
 }
 
-func (s *AssetService) GetFoundEvents(assetID string) ([]models.FoundEvent, error) {
-	return s.Repo.GetFoundEvents(assetID)
+// CancelTransfer withdraws an offer the recipient has not answered yet (sender only)
+func (s *AssetService) CancelTransfer(id string, userID uuid.UUID) (*dto.AssetTransferResponse, error) {
+	transfer, err := s.Repo.FindTransfer(id)
+	if err != nil {
+		return nil, errors.New("transfer not found")
+	}
+	if transfer.FromUserID != userID {
+		return nil, errors.New("only the sender can cancel the transfer")
+	}
+
+	if err := s.Repo.CloseTransfer(transfer, models.AssetTransferCancelled); err != nil {
+		return nil, err
+	}
+
+	s.NotifService.CreateNotification(
+		transfer.ToUserID,
+		"Asset Transfer Cancelled",
+		fmt.Sprintf("%s withdrew the offer of the asset '%s'.", transfer.FromUser.Name, transfer.Asset.Description),
+		"ASSET_TRANSFER",
+		transfer.ID,
+	)
+	return toAssetTransferResponse(transfer, userID), nil
 }
 
-func (s *AssetService) GetLostAssets() ([]dto.AssetResponse, error) {
-	assets, err := s.Repo.FindLostAssets()
+func (s *AssetService) GetLostAssets(page pagination.Params) (*dto.Page, error) {
+	assets, total, err := s.Repo.FindLostAssetsPage(page)
 	if err != nil {
 		return nil, err
 	}
+	assets, nextCursor := pagination.Trim(assets, page, assetCursorKey)
 
-	var responses []dto.AssetResponse
+	responses := []dto.AssetResponse{}
 	for _, asset := range assets {
-		responses = append(responses, dto.AssetResponse{
+		resp := dto.AssetResponse{
 			ID:           asset.ID,
 			OwnerID:      asset.OwnerID,
 			CategoryID:   asset.CategoryID,
 			CategoryName: asset.Category.Name, // Preloaded in Repo
 			Description:  asset.Description,
 			LostMode:     asset.LostMode,
-			QRCodeURL:    asset.QRCodeURL,
 			CreatedAt:    asset.CreatedAt,
-			// PrivateImageURL is intentionally omitted for public feed
-		})
+			// PrivateImageURL and the QR code are intentionally omitted for public feed
+		}
+		if len(asset.LostEpisodes) > 0 {
+			episode := asset.LostEpisodes[0]
+			resp.LostSince = &episode.LostSince
+			resp.LastSeenLocation = episodeLocationName(&episode)
+		}
+		responses = append(responses, resp)
 	}
-	return responses, nil
+	return &dto.Page{Data: responses, NextCursor: nextCursor, Total: total}, nil
 }
 
-func (s *AssetService) GetUserAssets(userID uuid.UUID) ([]dto.AssetResponse, error) {
-	assets, err := s.Repo.FindByOwnerID(userID.String())
+func (s *AssetService) GetUserAssets(userID uuid.UUID, page pagination.Params) (*dto.Page, error) {
+	assets, total, err := s.Repo.FindByOwnerID(userID.String(), page)
 	if err != nil {
 		return nil, err
 	}
+	assets, nextCursor := pagination.Trim(assets, page, assetCursorKey)
 
-	var responses []dto.AssetResponse
+	responses := []dto.AssetResponse{}
 	for _, asset := range assets {
 		responses = append(responses, dto.AssetResponse{
 			ID:              asset.ID,
@@ -157,8 +694,43 @@ func (s *AssetService) GetUserAssets(userID uuid.UUID) ([]dto.AssetResponse, err
 			PrivateImageURL: asset.PrivateImageURL, // Owner can see private image
 			LostMode:        asset.LostMode,
 			QRCodeURL:       asset.QRCodeURL,
+			ScanURL:         ScanURL(&asset),
 			CreatedAt:       asset.CreatedAt,
 		})
 	}
-	return responses, nil
+	return &dto.Page{Data: responses, NextCursor: nextCursor, Total: total}, nil
+}
+
+func assetCursorKey(asset models.Asset) (string, uuid.UUID) {
+	return pagination.TimeValue(asset.CreatedAt), asset.ID
+}
+
+// episodeLocationName prefers the campus location name over the free text description
+func episodeLocationName(episode *models.AssetLostEpisode) string {
+	if episode.Location != nil {
+		return episode.Location.Name
+	}
+	return episode.LocationDescription
+}
+
+func toAssetTransferResponse(transfer *models.AssetTransfer, userID uuid.UUID) *dto.AssetTransferResponse {
+	direction := "OUTGOING"
+	if transfer.ToUserID == userID {
+		direction = "INCOMING"
+	}
+	return &dto.AssetTransferResponse{
+		ID:               transfer.ID,
+		AssetID:          transfer.AssetID,
+		AssetDescription: transfer.Asset.Description,
+		CategoryName:     transfer.Asset.Category.Name,
+		FromUserID:       transfer.FromUserID,
+		FromUserName:     transfer.FromUser.Name,
+		ToUserID:         transfer.ToUserID,
+		ToUserName:       transfer.ToUser.Name,
+		Direction:        direction,
+		Status:           string(transfer.Status),
+		Note:             transfer.Note,
+		CreatedAt:        transfer.CreatedAt,
+		RespondedAt:      transfer.RespondedAt,
+	}
 }
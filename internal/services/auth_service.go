@@ -18,38 +18,13 @@ func NewAuthService(userRepo *repository.UserRepository) *AuthService {
 }
 
 func (s *AuthService) Register(req dto.RegisterRequest) (*dto.AuthResponse, error) {
-	// Validate that email starts with identity number
-	if !strings.HasPrefix(req.Email, req.IdentityNumber) {
-		return nil, errors.New("Email validation failed. Your email must start with your NIM/NIP.")
-	}
-
-	// Check if identity number already exists
-	existingByIdentity, _ := s.UserRepo.FindByIdentityNumber(req.IdentityNumber)
-	if existingByIdentity != nil {
-		return nil, errors.New("identity number already registered")
-	}
-
-	existingUser, _ := s.UserRepo.FindByEmail(req.Email)
-	if existingUser != nil {
-		return nil, errors.New("email already registered")
-	}
-
-	// Validate phone format (Indonesian phone numbers)
-	if len(req.Phone) < 10 || len(req.Phone) > 15 {
-		return nil, errors.New("invalid phone number format: must be between 10-15 digits")
-	}
-	if !strings.HasPrefix(req.Phone, "08") && !strings.HasPrefix(req.Phone, "+62") {
-		return nil, errors.New("invalid phone number format: must start with 08 or +62")
-	}
-
-	hashedPassword, err := utils.HashPassword(req.Password)
-	if err != nil {
-		return nil, err
-	}
-
 	role := models.RoleUser // Default to PUBLIK
 	if req.Role != "" {
 		role = models.UserRole(req.Role)
+		// ADMIN and SECURITY accounts are only created by an admin or the bootstrap CLI
+		if role == models.RoleAdmin || role == models.RoleSecurity {
+			return nil, errors.New("role not allowed: ADMIN and SECURITY accounts can only be created by an admin")
+		}
 	} else {
 		// Auto-assign based on email domain if role not specified (optional logic)
 		if strings.HasSuffix(req.Email, "@students.uii.ac.id") {
@@ -59,30 +34,9 @@ func (s *AuthService) Register(req dto.RegisterRequest) (*dto.AuthResponse, erro
 		}
 	}
 
-	var faculty *string
-	if role == models.RoleStudent && req.Faculty != "" {
-		f := req.Faculty
-		faculty = &f
-	} else if role == models.RoleStaff {
-		faculty = nil // Explicitly null for Staff/Dosen
-	} else if req.Faculty != "" {
-		// Allow faculty for others? Prompt says "If User is Staff/Dosen, faculty value is null".
-		// Implies others might have it? Or only Student?
-		// "For model User... additional field, which is faculty. There's an exception where if the User is Staff/Dosen, the faculty value is null"
-		// Let's assume only Students need it, or maybe Publik too?
-		// Safest is to allow it if provided, unless it's Staff/Dosen.
-		f := req.Faculty
-		faculty = &f
-	}
-
-	user := &models.User{
-		Name:           req.Name,
-		Email:          req.Email,
-		PasswordHash:   hashedPassword,
-		Phone:          req.Phone,
-		IdentityNumber: req.IdentityNumber,
-		Role:           role,
-		Faculty:        faculty,
+	user, err := newUser(s.UserRepo, req, role)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := s.UserRepo.Create(user); err != nil {
@@ -208,3 +162,64 @@ func (s *AuthService) RefreshToken(req dto.RefreshTokenRequest) (*dto.AuthRespon
 		},
 	}, nil
 }
+
+// newUser validates a new account and hashes its password. Used by self-registration and
+// by admins creating accounts, so both go through the same checks.
+func newUser(repo *repository.UserRepository, req dto.RegisterRequest, role models.UserRole) (*models.User, error) {
+	// Validate that email starts with identity number
+	if !strings.HasPrefix(req.Email, req.IdentityNumber) {
+		return nil, errors.New("Email validation failed. Your email must start with your NIM/NIP.")
+	}
+
+	// Check if identity number already exists
+	existingByIdentity, _ := repo.FindByIdentityNumber(req.IdentityNumber)
+	if existingByIdentity != nil {
+		return nil, errors.New("identity number already registered")
+	}
+
+	existingUser, _ := repo.FindByEmail(req.Email)
+	if existingUser != nil {
+		return nil, errors.New("email already registered")
+	}
+
+	// Validate phone format (Indonesian phone numbers)
+	if len(req.Phone) < 10 || len(req.Phone) > 15 {
+		return nil, errors.New("invalid phone number format: must be between 10-15 digits")
+	}
+	if !strings.HasPrefix(req.Phone, "08") && !strings.HasPrefix(req.Phone, "+62") {
+		return nil, errors.New("invalid phone number format: must start with 08 or +62")
+	}
+
+	hashedPassword, err := utils.HashPassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	var faculty *string
+	if role == models.RoleStudent && req.Faculty != "" {
+		f := req.Faculty
+		faculty = &f
+	} else if role == models.RoleStaff {
+		faculty = nil // Explicitly null for Staff/Dosen
+	} else if req.Faculty != "" {
+		// Allow faculty for others? Prompt says "If User is Staff/Dosen, faculty value is null".
+		// Implies others might have it? Or only Student?
+		// "For model User... additional field, which is faculty. There's an exception where if the User is Staff/Dosen, the faculty value is null"
+		// Let's assume only Students need it, or maybe Publik too?
+		// Safest is to allow it if provided, unless it's Staff/Dosen.
+		f := req.Faculty
+		faculty = &f
+	}
+
+	user := &models.User{
+		Name:           req.Name,
+		Email:          req.Email,
+		PasswordHash:   hashedPassword,
+		Phone:          req.Phone,
+		IdentityNumber: req.IdentityNumber,
+		Role:           role,
+		Faculty:        faculty,
+	}
+
+	return user, nil
+}
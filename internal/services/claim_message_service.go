@@ -0,0 +1,159 @@
+package services
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/repository"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Thread roles shown next to each message
+const (
+	threadRoleFinder   = "FINDER"
+	threadRoleClaimant = "CLAIMANT"
+	threadRoleSecurity = "SECURITY"
+)
+
+// ClaimMessageService runs the per-claim thread where the finder can ask the claimant
+// follow-up questions before deciding, and both can arrange the handover
+type ClaimMessageService struct {
+	ClaimRepo     *repository.ClaimRepository
+	UploadService *UploadService
+	NotifService  *NotificationService
+}
+
+func NewClaimMessageService(claimRepo *repository.ClaimRepository, uploadService *UploadService, notifService *NotificationService) *ClaimMessageService {
+	return &ClaimMessageService{
+		ClaimRepo:     claimRepo,
+		UploadService: uploadService,
+		NotifService:  notifService,
+	}
+}
+
+// PostMessage adds a message, with an optional image, and notifies the other side of the thread
+func (s *ClaimMessageService) PostMessage(claimID string, req dto.ClaimMessageRequest, file multipart.File, header *multipart.FileHeader, senderID uuid.UUID, role string) (*dto.ClaimMessageResponse, error) {
+	claim, threadRole, err := s.threadAccess(claimID, senderID, role)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Status != models.ClaimStatusPending && claim.Status != models.ClaimStatusApproved {
+		return nil, errors.New("claim is closed for messages")
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" && file == nil {
+		return nil, errors.New("message needs a body or an attachment")
+	}
+
+	message := &models.ClaimMessage{
+		ClaimID:  claim.ID,
+		SenderID: senderID,
+		Body:     body,
+	}
+	if file != nil {
+		url, err := s.UploadService.UploadFile(file, header)
+		if err != nil {
+			return nil, err
+		}
+		message.AttachmentURL = url
+	}
+
+	if err := s.ClaimRepo.CreateMessage(message); err != nil {
+		return nil, err
+	}
+
+	preview := body
+	if preview == "" {
+		preview = "Sent an image."
+	} else if runes := []rune(preview); len(runes) > 80 {
+		preview = string(runes[:77]) + "..."
+	}
+	notification := fmt.Sprintf("New message about '%s': %s", claim.Item.Title, preview)
+	for _, recipient := range threadRecipients(claim, senderID) {
+		s.NotifService.CreateNotification(recipient, "New Claim Message", notification, "CLAIM_MESSAGE", claim.ID)
+	}
+
+	return &dto.ClaimMessageResponse{
+		ID:            message.ID,
+		ClaimID:       message.ClaimID,
+		SenderID:      message.SenderID,
+		SenderName:    message.Sender.Name,
+		SenderRole:    threadRole,
+		Body:          message.Body,
+		AttachmentURL: message.AttachmentURL,
+		CreatedAt:     message.CreatedAt,
+	}, nil
+}
+
+// GetMessages returns the thread, oldest first
+func (s *ClaimMessageService) GetMessages(claimID string, userID uuid.UUID, role string) ([]dto.ClaimMessageResponse, error) {
+	claim, _, err := s.threadAccess(claimID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.ClaimRepo.FindMessages(claimID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := []dto.ClaimMessageResponse{}
+	for _, m := range messages {
+		responses = append(responses, dto.ClaimMessageResponse{
+			ID:            m.ID,
+			ClaimID:       m.ClaimID,
+			SenderID:      m.SenderID,
+			SenderName:    m.Sender.Name,
+			SenderRole:    threadRoleOf(claim, m.SenderID),
+			Body:          m.Body,
+			AttachmentURL: m.AttachmentURL,
+			CreatedAt:     m.CreatedAt,
+		})
+	}
+	return responses, nil
+}
+
+// threadAccess loads the claim if the user is its finder, its claimant or security staff
+func (s *ClaimMessageService) threadAccess(claimID string, userID uuid.UUID, role string) (*models.Claim, string, error) {
+	claim, err := s.ClaimRepo.FindByID(claimID)
+	if err != nil {
+		return nil, "", errors.New("claim not found")
+	}
+
+	threadRole := threadRoleOf(claim, userID)
+	if threadRole == threadRoleSecurity && role != string(models.RoleSecurity) && role != string(models.RoleAdmin) {
+		return nil, "", errors.New("only the finder, the claimant and security can access this thread")
+	}
+	return claim, threadRole, nil
+}
+
+// threadRoleOf names a participant. Anyone who is neither the finder nor the claimant is
+// treated as security; access checks make sure they really are.
+func threadRoleOf(claim *models.Claim, userID uuid.UUID) string {
+	switch {
+	case claim.Item.FinderID != nil && *claim.Item.FinderID == userID:
+		return threadRoleFinder
+	case claim.OwnerID == userID:
+		return threadRoleClaimant
+	default:
+		return threadRoleSecurity
+	}
+}
+
+// threadRecipients is everyone on the thread except the sender. Security is not notified,
+// they follow threads from the desk.
+func threadRecipients(claim *models.Claim, senderID uuid.UUID) []uuid.UUID {
+	var recipients []uuid.UUID
+	if claim.Item.FinderID != nil && *claim.Item.FinderID != senderID {
+		recipients = append(recipients, *claim.Item.FinderID)
+	}
+	if claim.OwnerID != senderID {
+		recipients = append(recipients, claim.OwnerID)
+	}
+	return recipients
+}
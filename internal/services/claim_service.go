@@ -0,0 +1,205 @@
+package services
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/lifecycle"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
+	"campus-lost-and-found/internal/repository"
+	"campus-lost-and-found/internal/utils"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ClaimService covers the claimant's side of a claim: their history, withdrawing a claim
+// and appealing a rejection to a SECURITY or ADMIN reviewer
+type ClaimService struct {
+	ClaimRepo    *repository.ClaimRepository
+	NotifService *NotificationService
+	Items        *ItemService
+}
+
+func NewClaimService(claimRepo *repository.ClaimRepository, notifService *NotificationService, items *ItemService) *ClaimService {
+	return &ClaimService{
+		ClaimRepo:    claimRepo,
+		NotifService: notifService,
+		Items:        items,
+	}
+}
+
+// GetMyClaims lists the user's claims with their status, newest first by default
+func (s *ClaimService) GetMyClaims(userID uuid.UUID, page pagination.Params) (*dto.Page, error) {
+	claims, total, err := s.ClaimRepo.FindByOwnerID(userID.String(), page)
+	if err != nil {
+		return nil, err
+	}
+	claims, nextCursor := pagination.Trim(claims, page, func(c models.Claim) (string, uuid.UUID) {
+		return pagination.TimeValue(c.CreatedAt), c.ID
+	})
+
+	responses := []dto.MyClaimResponse{}
+	for _, claim := range claims {
+		responses = append(responses, toMyClaimResponse(&claim))
+	}
+	return &dto.Page{Data: responses, NextCursor: nextCursor, Total: total}, nil
+}
+
+// Withdraw takes a claim back. An approved claim can still be withdrawn before handover,
+// which reopens the item for other claimants.
+func (s *ClaimService) Withdraw(claimID string, userID uuid.UUID) error {
+	claim, err := s.ClaimRepo.FindByID(claimID)
+	if err != nil {
+		return errors.New("claim not found")
+	}
+	if claim.OwnerID != userID {
+		return errors.New("only the claimant can withdraw a claim")
+	}
+
+	previous, err := s.ClaimRepo.Withdraw(claim.ID, lifecycle.Change{Actor: lifecycle.ActorClaim, ActorID: &userID, Reason: "Approved claim withdrawn by the claimant"})
+	if err != nil {
+		return err
+	}
+
+	if claim.Item.FinderID != nil {
+		body := fmt.Sprintf("A claim on '%s' has been withdrawn by the claimant.", claim.Item.Title)
+		if previous == models.ClaimStatusApproved {
+			body = fmt.Sprintf("The approved claim on '%s' has been withdrawn. The item is open for claims again.", claim.Item.Title)
+		}
+		s.NotifService.CreateNotification(*claim.Item.FinderID, "Claim Withdrawn", body, "CLAIM_WITHDRAWN", claim.ID)
+	}
+	return nil
+}
+
+// Appeal escalates a rejected claim to the reviewers' queue. Each claim gets one appeal.
+func (s *ClaimService) Appeal(claimID string, req dto.AppealClaimRequest, userID uuid.UUID) (*dto.MyClaimResponse, error) {
+	claim, err := s.ClaimRepo.FindByID(claimID)
+	if err != nil {
+		return nil, errors.New("claim not found")
+	}
+	if claim.OwnerID != userID {
+		return nil, errors.New("only the claimant can appeal a claim")
+	}
+	if claim.AppealedAt != nil {
+		return nil, errors.New("claim has already been appealed")
+	}
+	if claim.Status != models.ClaimStatusRejected {
+		return nil, errors.New("only a rejected claim can be appealed")
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, errors.New("appeal reason is required")
+	}
+	if err := s.ClaimRepo.Appeal(claim.ID, reason); err != nil {
+		return nil, err
+	}
+
+	if claim.Item.FinderID != nil {
+		s.NotifService.CreateNotification(
+			*claim.Item.FinderID,
+			"Claim Appealed",
+			fmt.Sprintf("A rejected claim on '%s' has been appealed and will be reviewed by campus security.", claim.Item.Title),
+			"CLAIM_ESCALATED",
+			claim.ID,
+		)
+	}
+
+	updated, err := s.ClaimRepo.FindByID(claimID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toMyClaimResponse(updated)
+	return &resp, nil
+}
+
+// GetEscalated is the reviewers' queue of appealed claims, oldest appeal first, with the
+// claimant's answers readable
+func (s *ClaimService) GetEscalated() ([]models.Claim, error) {
+	claims, err := s.ClaimRepo.FindEscalated()
+	if err != nil {
+		return nil, err
+	}
+	for i := range claims {
+		for j := range claims[i].Answers {
+			if answer, err := utils.Decrypt(claims[i].Answers[j].Answer); err == nil {
+				claims[i].Answers[j].Answer = answer
+			}
+		}
+	}
+	return claims, nil
+}
+
+// Review records a reviewer's final decision on an appeal. Upholding the appeal approves the
+// claim the same way a finder would; otherwise the rejection stands with the reviewer's note.
+func (s *ClaimService) Review(claimID string, req dto.ReviewClaimRequest, reviewerID uuid.UUID) (*dto.MyClaimResponse, error) {
+	claim, err := s.ClaimRepo.FindByID(claimID)
+	if err != nil {
+		return nil, errors.New("claim not found")
+	}
+	if claim.Status != models.ClaimStatusEscalated {
+		return nil, errors.New("claim is not awaiting review")
+	}
+	if claim.OwnerID == reviewerID || (claim.Item.FinderID != nil && *claim.Item.FinderID == reviewerID) {
+		return nil, errors.New("you cannot review an appeal you are part of")
+	}
+
+	note := strings.TrimSpace(req.Note)
+	if req.Status == string(models.ClaimStatusApproved) {
+		change := lifecycle.Change{Actor: lifecycle.ActorClaim, ActorID: &reviewerID, Reason: "Appeal upheld on review: " + note}
+		if err := s.Items.approveClaim(claim, false, change); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := s.ClaimRepo.Reject(claim.ID, models.ClaimStatusEscalated, note); err != nil {
+			return nil, err
+		}
+		s.NotifService.CreateNotification(
+			claim.OwnerID,
+			"Appeal Rejected",
+			"Your appeal has been reviewed and the rejection stands. Note: "+note,
+			"CLAIM_REJECTED",
+			claim.ID,
+		)
+	}
+
+	if err := s.ClaimRepo.RecordReview(claim.ID, reviewerID, note); err != nil {
+		return nil, err
+	}
+
+	if claim.Item.FinderID != nil {
+		s.NotifService.CreateNotification(
+			*claim.Item.FinderID,
+			"Appeal Reviewed",
+			fmt.Sprintf("Campus security has %s an appealed claim on '%s'.", strings.ToLower(req.Status), claim.Item.Title),
+			"CLAIM_REVIEWED",
+			claim.ID,
+		)
+	}
+
+	updated, err := s.ClaimRepo.FindByID(claimID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toMyClaimResponse(updated)
+	return &resp, nil
+}
+
+func toMyClaimResponse(claim *models.Claim) dto.MyClaimResponse {
+	return dto.MyClaimResponse{
+		ID:           claim.ID,
+		ItemID:       claim.ItemID,
+		ItemTitle:    claim.Item.Title,
+		ItemStatus:   string(claim.Item.Status),
+		Status:       string(claim.Status),
+		Reason:       claim.Reason,
+		AutoApproved: claim.AutoApproved,
+		AppealReason: claim.AppealReason,
+		AppealedAt:   claim.AppealedAt,
+		ReviewNote:   claim.ReviewNote,
+		ReviewedAt:   claim.ReviewedAt,
+		CreatedAt:    claim.CreatedAt,
+	}
+}
@@ -0,0 +1,316 @@
+package services
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/repository"
+	"campus-lost-and-found/internal/utils"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustodyService tracks found items handed to security, from check-in at a desk
+// to check-out to the verified owner
+type CustodyService struct {
+	CustodyRepo  *repository.CustodyRepository
+	ItemRepo     *repository.ItemRepository
+	ClaimRepo    *repository.ClaimRepository
+	EnumRepo     *repository.EnumerationRepository
+	NotifService *NotificationService
+	Pickups      *PickupService
+}
+
+func NewCustodyService(custodyRepo *repository.CustodyRepository, itemRepo *repository.ItemRepository, claimRepo *repository.ClaimRepository, enumRepo *repository.EnumerationRepository, notifService *NotificationService, pickups *PickupService) *CustodyService {
+	return &CustodyService{
+		CustodyRepo:  custodyRepo,
+		ItemRepo:     itemRepo,
+		ClaimRepo:    claimRepo,
+		EnumRepo:     enumRepo,
+		NotifService: notifService,
+		Pickups:      pickups,
+	}
+}
+
+// CheckIn records a found item arriving at a security desk
+func (s *CustodyService) CheckIn(req dto.CheckInRequest, actorID uuid.UUID) (*dto.CustodyResponse, error) {
+	item, err := s.ItemRepo.FindByID(req.ItemID.String())
+	if err != nil {
+		return nil, errors.New("item not found")
+	}
+	if item.Type != models.ItemTypeFound {
+		return nil, errors.New("only found items can be checked in")
+	}
+	if item.Status == models.ItemStatusResolved {
+		return nil, errors.New("item has already been returned")
+	}
+
+	desk, err := s.findDesk(req.DeskID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	custody := &models.Custody{
+		Base:       models.Base{ID: uuid.New()},
+		ItemID:     item.ID,
+		DeskID:     desk.ID,
+		StorageBin: req.StorageBin,
+	}
+	event := &models.CustodyEvent{
+		Type:       models.CustodyEventCheckIn,
+		DeskID:     desk.ID,
+		StorageBin: req.StorageBin,
+		ActorID:    actorID,
+		Note:       req.Note,
+	}
+	if err := s.CustodyRepo.CheckIn(custody, event, func(e *models.CustodyEvent) string {
+		return utils.Sign(custodyEventPayload(e, ""))
+	}); err != nil {
+		return nil, err
+	}
+
+	item.ReturnMethod = models.ReturnMethodHandedToSecurity
+
+	if item.FinderID != nil {
+		s.NotifService.CreateNotification(
+			*item.FinderID,
+			"Item Checked In",
+			fmt.Sprintf("The item '%s' you found is now kept at %s.", item.Title, desk.Name),
+			"CUSTODY_UPDATE",
+			item.ID,
+		)
+	}
+	s.notifyClaimant(item, fmt.Sprintf("Your item '%s' is ready for pickup at %s.", item.Title, desk.Name))
+
+	custody.Item = *item
+	custody.Desk = *desk
+	return toCustodyResponse(custody), nil
+}
+
+// Transfer moves an item to another desk, or to another bin at the same desk
+func (s *CustodyService) Transfer(itemID string, req dto.TransferCustodyRequest, actorID uuid.UUID) (*dto.CustodyResponse, error) {
+	custody, err := s.CustodyRepo.FindActiveByItemID(itemID)
+	if err != nil {
+		return nil, err
+	}
+	if custody == nil {
+		return nil, errors.New("item is not in custody")
+	}
+
+	desk, err := s.findDesk(req.DeskID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	event := &models.CustodyEvent{
+		Type:       models.CustodyEventMove,
+		DeskID:     desk.ID,
+		StorageBin: req.StorageBin,
+		ActorID:    actorID,
+		Note:       req.Note,
+	}
+	fromDeskID := custody.DeskID
+	if desk.ID != fromDeskID {
+		event.Type = models.CustodyEventTransfer
+		event.FromDeskID = &fromDeskID
+	} else if req.StorageBin == custody.StorageBin {
+		return nil, errors.New("item is already at this desk and storage bin")
+	}
+
+	custody.DeskID = desk.ID
+	custody.Desk = *desk
+	custody.StorageBin = req.StorageBin
+	if err := s.CustodyRepo.AppendEvent(custody, event, signCustodyEvent); err != nil {
+		return nil, err
+	}
+
+	if event.Type == models.CustodyEventTransfer {
+		s.notifyClaimant(&custody.Item, fmt.Sprintf("Your item '%s' has been moved to %s.", custody.Item.Title, desk.Name))
+	}
+
+	return toCustodyResponse(custody), nil
+}
+
+// CheckOut hands an item to the owner whose claim was approved. Security confirms the
+// owner in person and enters their NIM/NIP, which must match the claimant's account.
+// It is the fallback to the pickup code for claimants who cannot show theirs.
+func (s *CustodyService) CheckOut(itemID string, req dto.CheckOutRequest, actorID uuid.UUID) (*dto.CustodyResponse, error) {
+	custody, err := s.CustodyRepo.FindActiveByItemID(itemID)
+	if err != nil {
+		return nil, err
+	}
+	if custody == nil {
+		return nil, errors.New("item is not in custody")
+	}
+
+	claim, err := s.ClaimRepo.FindApprovedByItemID(itemID)
+	if err != nil {
+		return nil, errors.New("item has no approved claim")
+	}
+	if !strings.EqualFold(strings.TrimSpace(req.IdentityNumber), claim.Owner.IdentityNumber) {
+		return nil, errors.New("identity number does not match the approved claimant")
+	}
+
+	item, err := s.ItemRepo.FindByID(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	custody.CheckedOutAt = &now
+	custody.CheckedOutToID = &claim.OwnerID
+	err = s.Pickups.Complete(repository.Handover{
+		ActorID: actorID,
+		Custody: custody,
+		CustodyEvent: &models.CustodyEvent{
+			Type:        models.CustodyEventCheckOut,
+			DeskID:      custody.DeskID,
+			StorageBin:  custody.StorageBin,
+			ActorID:     actorID,
+			RecipientID: &claim.OwnerID,
+			Note:        req.Note,
+		},
+		Item:  item,
+		Claim: claim,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustodyResponse(custody), nil
+}
+
+// GetCustody returns an item's custody with its event chain and whether every signature holds
+func (s *CustodyService) GetCustody(itemID string) (*dto.CustodyResponse, error) {
+	custody, err := s.CustodyRepo.FindLatestByItemID(itemID)
+	if err != nil {
+		return nil, errors.New("item has never been in custody")
+	}
+
+	events, err := s.CustodyRepo.GetEvents(custody.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toCustodyResponse(custody)
+	verified := len(events) > 0
+	prevSignature := ""
+	for _, event := range events {
+		valid := utils.VerifySignature(custodyEventPayload(&event, prevSignature), event.Signature)
+		verified = verified && valid
+		prevSignature = event.Signature
+
+		eventResp := dto.CustodyEventResponse{
+			ID:          event.ID,
+			Type:        string(event.Type),
+			DeskID:      event.DeskID,
+			DeskName:    event.Desk.Name,
+			FromDeskID:  event.FromDeskID,
+			StorageBin:  event.StorageBin,
+			ActorID:     event.ActorID,
+			ActorName:   event.Actor.Name,
+			RecipientID: event.RecipientID,
+			Note:        event.Note,
+			Signature:   event.Signature,
+			Valid:       valid,
+			CreatedAt:   event.CreatedAt,
+		}
+		if event.FromDesk != nil {
+			eventResp.FromDeskName = event.FromDesk.Name
+		}
+		if event.Recipient != nil {
+			eventResp.RecipientName = event.Recipient.Name
+		}
+		resp.Events = append(resp.Events, eventResp)
+	}
+	resp.Verified = &verified
+
+	return resp, nil
+}
+
+// GetDeskInventory lists what a desk currently holds, by storage bin
+func (s *CustodyService) GetDeskInventory(deskID string) ([]dto.CustodyResponse, error) {
+	if _, err := s.EnumRepo.FindLocationByID(deskID); err != nil {
+		return nil, errors.New("desk not found")
+	}
+
+	custodies, err := s.CustodyRepo.FindByDesk(deskID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := []dto.CustodyResponse{}
+	for _, custody := range custodies {
+		responses = append(responses, *toCustodyResponse(&custody))
+	}
+	return responses, nil
+}
+
+// findDesk loads a campus location that is marked as a security desk
+func (s *CustodyService) findDesk(id string) (*models.CampusLocation, error) {
+	desk, err := s.EnumRepo.FindLocationByID(id)
+	if err != nil {
+		return nil, errors.New("invalid desk_id: location does not exist")
+	}
+	if !desk.IsSecurityDesk {
+		return nil, errors.New("invalid desk_id: location is not a security desk")
+	}
+	return desk, nil
+}
+
+func (s *CustodyService) notifyClaimant(item *models.Item, body string) {
+	claim, err := s.ClaimRepo.FindApprovedByItemID(item.ID.String())
+	if err != nil {
+		return
+	}
+	s.NotifService.CreateNotification(claim.OwnerID, "Item Pickup", body, "CUSTODY_UPDATE", item.ID)
+}
+
+func signCustodyEvent(event *models.CustodyEvent, prevSignature string) string {
+	return utils.Sign(custodyEventPayload(event, prevSignature))
+}
+
+// custodyEventPayload is the signed content of an event, chained to the previous signature
+func custodyEventPayload(e *models.CustodyEvent, prevSignature string) string {
+	optional := func(id *uuid.UUID) string {
+		if id == nil {
+			return ""
+		}
+		return id.String()
+	}
+	return strings.Join([]string{
+		prevSignature,
+		e.CustodyID.String(),
+		string(e.Type),
+		e.DeskID.String(),
+		optional(e.FromDeskID),
+		e.StorageBin,
+		e.ActorID.String(),
+		optional(e.RecipientID),
+		e.Note,
+		e.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}, "|")
+}
+
+func toCustodyResponse(custody *models.Custody) *dto.CustodyResponse {
+	resp := &dto.CustodyResponse{
+		ID:             custody.ID,
+		ItemID:         custody.ItemID,
+		ItemTitle:      custody.Item.Title,
+		CategoryName:   custody.Item.Category.Name,
+		DeskID:         custody.DeskID,
+		DeskName:       custody.Desk.Name,
+		StorageBin:     custody.StorageBin,
+		Status:         "IN_CUSTODY",
+		CheckedInAt:    custody.CreatedAt,
+		CheckedOutAt:   custody.CheckedOutAt,
+		CheckedOutToID: custody.CheckedOutToID,
+	}
+	if custody.CheckedOutAt != nil {
+		resp.Status = "CHECKED_OUT"
+	}
+	return resp
+}
@@ -2,11 +2,17 @@ package services
 
 import (
 	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/jobs"
+	"campus-lost-and-found/internal/lifecycle"
 	"campus-lost-and-found/internal/matching"
 	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
 	"campus-lost-and-found/internal/repository"
+	"campus-lost-and-found/internal/utils"
+	"encoding/json"
 	"errors"
-	"sort"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,9 +25,11 @@ type ItemService struct {
 	EnumRepo       *repository.EnumerationRepository
 	MatchingEngine *matching.MatchingEngine
 	NotifService   *NotificationService
+	Jobs           *jobs.Runner
+	Pickups        *PickupService
 }
 
-func NewItemService(itemRepo *repository.ItemRepository, assetRepo *repository.AssetRepository, claimRepo *repository.ClaimRepository, enumRepo *repository.EnumerationRepository, matchingEngine *matching.MatchingEngine, notifService *NotificationService) *ItemService {
+func NewItemService(itemRepo *repository.ItemRepository, assetRepo *repository.AssetRepository, claimRepo *repository.ClaimRepository, enumRepo *repository.EnumerationRepository, matchingEngine *matching.MatchingEngine, notifService *NotificationService, jobRunner *jobs.Runner, pickups *PickupService) *ItemService {
 	return &ItemService{
 		ItemRepo:       itemRepo,
 		AssetRepo:      assetRepo,
@@ -29,6 +37,8 @@ func NewItemService(itemRepo *repository.ItemRepository, assetRepo *repository.A
 		EnumRepo:       enumRepo,
 		MatchingEngine: matchingEngine,
 		NotifService:   notifService,
+		Jobs:           jobRunner,
+		Pickups:        pickups,
 	}
 }
 
@@ -38,12 +48,16 @@ func (s *ItemService) ReportFoundItem(req dto.CreateFoundItemRequest, finderID u
 		return nil, errors.New("invalid date format, use YYYY-MM-DD")
 	}
 
-	// Map Verifications
+	// Map Verifications (answers are stored encrypted)
 	var verifications []models.ItemVerification
 	for _, v := range req.Verifications {
+		answer, err := SealVerificationAnswer(v.Answer)
+		if err != nil {
+			return nil, err
+		}
 		verifications = append(verifications, models.ItemVerification{
 			Question: v.Question,
-			Answer:   v.Answer,
+			Answer:   answer,
 		})
 	}
 
@@ -70,24 +84,24 @@ func (s *ItemService) ReportFoundItem(req dto.CreateFoundItemRequest, finderID u
 		DateFound:     &dateFound,
 		ReturnMethod:  models.ReturnMethod(req.ReturnMethod),
 		COD:           req.COD,
+		AutoApprove:   req.AutoApprove,
 	}
 
 	if err := s.ItemRepo.Create(item); err != nil {
 		return nil, err
 	}
 
-	// Run Matching Engine
-	go func() {
-		lostAssets, err := s.AssetRepo.FindLostAssets()
-		if err == nil {
-			s.MatchingEngine.RunMatching(item, lostAssets)
-		}
-	}()
+	// Run Matching Engine. The item is already saved, so a failed enqueue is only logged; the
+	// periodic rematch picks the item up.
+	if err := s.Jobs.Enqueue(jobs.TypeMatchItem, jobs.ItemPayload{ItemID: item.ID}); err != nil {
+		log.Printf("items: could not queue matching for item %s: %v", item.ID, err)
+	}
 
 	// Map response verifications
 	var verifResponses []dto.VerificationResponse
 	for _, v := range item.Verifications {
 		verifResponses = append(verifResponses, dto.VerificationResponse{
+			ID:       v.ID,
 			Question: v.Question,
 		})
 	}
@@ -112,6 +126,7 @@ func (s *ItemService) ReportFoundItem(req dto.CreateFoundItemRequest, finderID u
 		Contacts:      contactResponses,
 		Status:        string(item.Status),
 		CreatedAt:     item.CreatedAt,
+		AutoApprove:   item.AutoApprove,
 	}, nil
 }
 
@@ -174,6 +189,11 @@ func (s *ItemService) ReportLostItem(req dto.CreateLostItemRequest, ownerID uuid
 		return nil, err
 	}
 
+	// Run Matching Engine against open found items, logged like in ReportFoundItem
+	if err := s.Jobs.Enqueue(jobs.TypeMatchItem, jobs.ItemPayload{ItemID: item.ID}); err != nil {
+		log.Printf("items: could not queue matching for item %s: %v", item.ID, err)
+	}
+
 	// Map response contacts
 	var contactResponses []dto.ContactResponse
 	for _, c := range item.Contacts {
@@ -197,6 +217,121 @@ func (s *ItemService) ReportLostItem(req dto.CreateLostItemRequest, ownerID uuid
 	}, nil
 }
 
+// HandleMatchItem is the job handler that runs the matching engine for a new item
+func (s *ItemService) HandleMatchItem(payload []byte) error {
+	var p jobs.ItemPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return err
+	}
+
+	// FindByID preloads Location, which is needed for distance scoring
+	item, err := s.ItemRepo.FindByID(p.ItemID.String())
+	if err != nil {
+		return err
+	}
+
+	if item.Type == models.ItemTypeFound {
+		return s.matchFoundItem(item)
+	}
+	return s.matchLostItem(item)
+}
+
+// HandleRematchSweep is the periodic job that re-runs matching for OPEN items and
+// lost-mode assets that changed since the last sweep, so reports filed on different days
+// still meet. Pairs that were already suggested are not notified again (see MatchStore).
+func (s *ItemService) HandleRematchSweep(payload []byte) error {
+	startedAt := time.Now()
+	since, err := s.Jobs.Repo.GetLastRun(jobs.TypeRematchSweep)
+	if err != nil {
+		return err
+	}
+
+	foundItems, err := s.ItemRepo.FindOpenByType(string(models.ItemTypeFound))
+	if err != nil {
+		return err
+	}
+	lostItems, err := s.ItemRepo.FindOpenByType(string(models.ItemTypeLost))
+	if err != nil {
+		return err
+	}
+	lostAssets, err := s.AssetRepo.FindLostAssets()
+	if err != nil {
+		return err
+	}
+
+	var found, changedFound, lost, changedLost []matching.Report
+	for i := range foundItems {
+		report := matching.FromItem(&foundItems[i])
+		found = append(found, report)
+		if foundItems[i].UpdatedAt.After(since) {
+			changedFound = append(changedFound, report)
+		}
+	}
+	for i := range lostItems {
+		report := matching.FromItem(&lostItems[i])
+		lost = append(lost, report)
+		if lostItems[i].UpdatedAt.After(since) {
+			changedLost = append(changedLost, report)
+		}
+	}
+	for i := range lostAssets {
+		report := matching.FromAsset(&lostAssets[i])
+		lost = append(lost, report)
+		if lostAssets[i].UpdatedAt.After(since) {
+			changedLost = append(changedLost, report)
+		}
+	}
+
+	for _, f := range changedFound {
+		s.MatchingEngine.MatchFound(f, lost)
+	}
+	for _, l := range changedLost {
+		s.MatchingEngine.MatchLost(l, found)
+	}
+
+	return s.Jobs.Repo.SetLastRun(jobs.TypeRematchSweep, startedAt)
+}
+
+// matchFoundItem checks a found item against open lost items and lost-mode assets
+func (s *ItemService) matchFoundItem(item *models.Item) error {
+	var lost []matching.Report
+
+	lostItems, err := s.ItemRepo.FindOpenByType(string(models.ItemTypeLost))
+	if err != nil {
+		return err
+	}
+	for i := range lostItems {
+		lost = append(lost, matching.FromItem(&lostItems[i]))
+	}
+
+	lostAssets, err := s.AssetRepo.FindLostAssets()
+	if err != nil {
+		return err
+	}
+	for i := range lostAssets {
+		lost = append(lost, matching.FromAsset(&lostAssets[i]))
+	}
+
+	s.MatchingEngine.MatchFound(matching.FromItem(item), lost)
+	return nil
+}
+
+// matchLostItem checks a lost item against open found items
+func (s *ItemService) matchLostItem(item *models.Item) error {
+	foundItems, err := s.ItemRepo.FindOpenByType(string(models.ItemTypeFound))
+	if err != nil {
+		return err
+	}
+
+	var found []matching.Report
+	for i := range foundItems {
+		found = append(found, matching.FromItem(&foundItems[i]))
+	}
+
+	s.MatchingEngine.MatchLost(matching.FromItem(item), found)
+	return nil
+}
+
 func (s *ItemService) GetItem(id string, userID uuid.UUID) (*dto.ItemResponse, error) {
 	item, err := s.ItemRepo.FindByID(id)
 	if err != nil {
@@ -207,6 +342,7 @@ func (s *ItemService) GetItem(id string, userID uuid.UUID) (*dto.ItemResponse, e
 	var verifResponses []dto.VerificationResponse
 	for _, v := range item.Verifications {
 		verifResponses = append(verifResponses, dto.VerificationResponse{
+			ID:       v.ID,
 			Question: v.Question,
 		})
 	}
@@ -266,6 +402,10 @@ func (s *ItemService) GetItem(id string, userID uuid.UUID) (*dto.ItemResponse, e
 		}
 	}
 
+	if item.FinderID != nil && *item.FinderID == userID {
+		resp.AutoApprove = item.AutoApprove
+	}
+
 	// Check User Claim Status
 	if userID != uuid.Nil {
 		claim, err := s.ClaimRepo.FindByUserAndItem(userID.String(), item.ID.String())
@@ -277,122 +417,164 @@ func (s *ItemService) GetItem(id string, userID uuid.UUID) (*dto.ItemResponse, e
 	return resp, nil
 }
 
-func (s *ItemService) GetAllItems(status string, itemType string) ([]dto.ItemResponse, error) {
-	var itemResponses []dto.ItemResponse
-
-	// 1. Fetch Items (Ad-Hoc)
-	items, err := s.ItemRepo.FindAll(status, itemType)
+// GetAllItems returns one page of the merged feed of ad-hoc items and lost assets
+func (s *ItemService) GetAllItems(status string, itemType string, page pagination.Params) (*dto.Page, error) {
+	rows, total, err := s.ItemRepo.FindFeed(status, itemType, page)
 	if err != nil {
 		return nil, err
 	}
+	rows, nextCursor := pagination.Trim(rows, page, func(row repository.FeedRow) (string, uuid.UUID) {
+		return pagination.TimeValue(row.SortTime), row.ID
+	})
 
-	for _, item := range items {
-		// Map verifications
-		var verifResponses []dto.VerificationResponse
-		for _, v := range item.Verifications {
-			verifResponses = append(verifResponses, dto.VerificationResponse{
-				Question: v.Question,
-			})
+	// Load the records on this page
+	var itemIDs, assetIDs []uuid.UUID
+	for _, row := range rows {
+		if row.Kind == "ASSET" {
+			assetIDs = append(assetIDs, row.ID)
+		} else {
+			itemIDs = append(itemIDs, row.ID)
 		}
+	}
 
-		resp := dto.ItemResponse{
-			ID:            item.ID,
-			Title:         item.Title,
-			Type:          string(item.Type),
-			Description:   item.Description,
-			CategoryID:    item.CategoryID,
-			ImageURL:      item.ImageURL,
-			Status:        string(item.Status),
-			CreatedAt:     item.CreatedAt,
-			Verifications: verifResponses,
-			Urgency:       string(item.Urgency),
-			OfferReward:   item.OfferReward,
-			ShowPhone:     item.ShowPhone,
-		}
+	items, err := s.ItemRepo.FindByIDs(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	itemsByID := make(map[uuid.UUID]models.Item, len(items))
+	for _, item := range items {
+		itemsByID[item.ID] = item
+	}
 
-		// Map Dates
-		if item.DateLost != nil {
-			resp.DateLost = item.DateLost.Format("2006-01-02")
-		}
-		if item.DateFound != nil {
-			resp.DateFound = item.DateFound.Format("2006-01-02")
-		}
+	lostAssets, err := s.AssetRepo.FindLostByIDs(assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	assetsByID := make(map[uuid.UUID]models.Asset, len(lostAssets))
+	for _, asset := range lostAssets {
+		assetsByID[asset.ID] = asset
+	}
 
-		// Map Users
-		if item.Finder != nil {
-			resp.Finder = &dto.ItemUserResponse{
-				ID:   item.Finder.ID,
-				Name: item.Finder.Name,
-				Role: string(item.Finder.Role),
-			}
-		}
-		if item.Owner != nil {
-			resp.Owner = &dto.ItemUserResponse{
-				ID:   item.Owner.ID,
-				Name: item.Owner.Name,
-				Role: string(item.Owner.Role),
+	// Keep the feed order
+	itemResponses := []dto.ItemResponse{}
+	for _, row := range rows {
+		if row.Kind == "ASSET" {
+			if asset, ok := assetsByID[row.ID]; ok {
+				itemResponses = append(itemResponses, lostAssetToItemResponse(&asset))
 			}
+		} else if item, ok := itemsByID[row.ID]; ok {
+			itemResponses = append(itemResponses, feedItemResponse(&item))
 		}
+	}
 
-		if item.LocationID != nil {
-			resp.LocationID = *item.LocationID
-		}
-		if item.Location != nil {
-			resp.LocationName = item.Location.Name
-		} else if item.LocationDescription != "" {
-			resp.LocationName = item.LocationDescription
-		}
+	return &dto.Page{
+		Data:       itemResponses,
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
+}
 
-		itemResponses = append(itemResponses, resp)
+// feedItemResponse maps an ad-hoc item for the feed
+func feedItemResponse(item *models.Item) dto.ItemResponse {
+	// Map verifications
+	var verifResponses []dto.VerificationResponse
+	for _, v := range item.Verifications {
+		verifResponses = append(verifResponses, dto.VerificationResponse{
+			ID:       v.ID,
+			Question: v.Question,
+		})
 	}
 
-	// 2. Fetch Lost Assets (Registered) - Only if we want LOST items or ALL items
-	// And only if status is OPEN or empty (assuming lost assets are effectively "OPEN" lost items)
-	if (itemType == "" || itemType == "LOST") && (status == "" || status == "OPEN") {
-		lostAssets, err := s.AssetRepo.FindLostAssets()
-		if err != nil {
-			return nil, err
-		}
+	resp := dto.ItemResponse{
+		ID:            item.ID,
+		Title:         item.Title,
+		Type:          string(item.Type),
+		Description:   item.Description,
+		CategoryID:    item.CategoryID,
+		ImageURL:      item.ImageURL,
+		Status:        string(item.Status),
+		CreatedAt:     item.CreatedAt,
+		Verifications: verifResponses,
+		Urgency:       string(item.Urgency),
+		OfferReward:   item.OfferReward,
+		ShowPhone:     item.ShowPhone,
+	}
 
-		for _, asset := range lostAssets {
-			resp := dto.ItemResponse{
-				ID:           asset.ID,
-				Title:        asset.Description, // Use description as title for assets
-				Type:         "LOST",
-				Description:  asset.Description,
-				CategoryID:   asset.CategoryID,
-				ImageURL:     asset.PrivateImageURL, // Show private image for lost assets so people can identify
-				Status:       "LOST",
-				CreatedAt:    asset.UpdatedAt, // Use UpdatedAt as the time it was marked lost
-				DateLost:     asset.UpdatedAt.Format("2006-01-02"),
-				LocationName: "Registered Asset",
-				Owner: &dto.ItemUserResponse{
-					ID:   asset.Owner.ID,
-					Name: asset.Owner.Name,
-					Role: string(asset.Owner.Role),
-				},
-			}
-			itemResponses = append(itemResponses, resp)
+	// Map Dates
+	if item.DateLost != nil {
+		resp.DateLost = item.DateLost.Format("2006-01-02")
+	}
+	if item.DateFound != nil {
+		resp.DateFound = item.DateFound.Format("2006-01-02")
+	}
+
+	// Map Users
+	if item.Finder != nil {
+		resp.Finder = &dto.ItemUserResponse{
+			ID:   item.Finder.ID,
+			Name: item.Finder.Name,
+			Role: string(item.Finder.Role),
+		}
+	}
+	if item.Owner != nil {
+		resp.Owner = &dto.ItemUserResponse{
+			ID:   item.Owner.ID,
+			Name: item.Owner.Name,
+			Role: string(item.Owner.Role),
 		}
 	}
 
-	// 3. Sort by CreatedAt Descending
-	// We need to sort the combined list.
-	// Since we appended, it might be out of order.
-	// Let's use a simple bubble sort or slice.SortFunc if Go 1.21+ (we are on 1.24)
-	// But to avoid importing "sort" or "slices" if not already imported, I'll check imports.
-	// "sort" is not imported. I should add it or use a simple loop.
-	// Given the list size might be small, I'll add "sort" import.
+	if item.LocationID != nil {
+		resp.LocationID = *item.LocationID
+	}
+	if item.Location != nil {
+		resp.LocationName = item.Location.Name
+	} else if item.LocationDescription != "" {
+		resp.LocationName = item.LocationDescription
+	}
 
-	// 3. Sort by CreatedAt Descending
-	sort.Slice(itemResponses, func(i, j int) bool {
-		return itemResponses[i].CreatedAt.After(itemResponses[j].CreatedAt)
-	})
+	return resp
+}
+
+// lostAssetToItemResponse shows a registered asset in lost mode as an OPEN-like LOST entry of the feed.
+// Redacted like the public feed: the private image and the owner stay hidden.
+func lostAssetToItemResponse(asset *models.Asset) dto.ItemResponse {
+	resp := dto.ItemResponse{
+		ID:           asset.ID,
+		Title:        asset.Description, // Use description as title for assets
+		Type:         "LOST",
+		Description:  asset.Description,
+		CategoryID:   asset.CategoryID,
+		CategoryName: asset.Category.Name,
+		Status:       "LOST",
+		CreatedAt:    asset.UpdatedAt, // Fallback when there is no lost episode
+		DateLost:     asset.UpdatedAt.Format("2006-01-02"),
+		LocationName: "Registered Asset",
+	}
 
-	return itemResponses, nil
+	// Use the open lost episode for when and where it was lost
+	if len(asset.LostEpisodes) > 0 {
+		episode := asset.LostEpisodes[0]
+		resp.CreatedAt = episode.LostSince
+		resp.DateLost = episode.LostSince.Format("2006-01-02")
+		if episode.LocationID != nil {
+			resp.LocationID = *episode.LocationID
+		}
+		if name := episodeLocationName(&episode); name != "" {
+			resp.LocationName = name
+		}
+	}
+
+	return resp
 }
 
 func (s *ItemService) SubmitClaim(itemID string, req dto.CreateClaimRequest, ownerID uuid.UUID) (*dto.ClaimResponse, error) {
+	return s.submitClaim(itemID, req, ownerID, uuid.New())
+}
+
+// submitClaim files the claim under the given ID, so an accepted match can point to it before
+// it exists. Errors are only returned before the claim is stored.
+func (s *ItemService) submitClaim(itemID string, req dto.CreateClaimRequest, ownerID, claimID uuid.UUID) (*dto.ClaimResponse, error) {
 	item, err := s.ItemRepo.FindByID(itemID)
 	if err != nil {
 		return nil, err
@@ -402,33 +584,75 @@ func (s *ItemService) SubmitClaim(itemID string, req dto.CreateClaimRequest, own
 		return nil, errors.New("item is not open for claims")
 	}
 
+	if item.FinderID != nil && *item.FinderID == ownerID {
+		return nil, errors.New("you cannot claim an item you found")
+	}
+
+	answers, allExact, err := scoreClaimAnswers(item.Verifications, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+
 	claim := &models.Claim{
+		ID:          claimID,
 		ItemID:      item.ID,
 		OwnerID:     ownerID,
 		AnswerInput: req.AnswerInput,
 		ImageURL:    req.ImageURL,
 		Status:      models.ClaimStatusPending,
+		Answers:     answers,
+	}
+	for _, answer := range answers {
+		claim.AnswerScore += answer.Score / float64(len(answers))
 	}
 
-	if err := s.ClaimRepo.Create(claim); err != nil {
+	// Rejects a second pending claim from the same user
+	firstClaim, err := s.ClaimRepo.CreatePending(claim)
+	if err != nil {
 		return nil, err
 	}
 
+	// Finder opted in and every answer matched exactly: approve without waiting for them.
+	// Only a user's first claim on the item qualifies, otherwise the APPROVED/PENDING status
+	// would let them withdraw and resubmit until they guess the answers.
+	// The claim is already filed, so if approval fails it is logged and left for the finder.
+	autoApproved := false
+	if item.AutoApprove && allExact && firstClaim {
+		err := s.approveClaim(claim, true, lifecycle.Change{Actor: lifecycle.ActorClaim, ActorID: &ownerID, Reason: "Claim auto-approved: every answer matched exactly"})
+		if err != nil {
+			log.Printf("claims: could not auto-approve claim %s: %v", claim.ID, err)
+		}
+		if claim.Status == models.ClaimStatusApproved {
+			autoApproved = true
+			claim.AutoApproved = true
+		}
+	}
+
 	// Notify Finder
 	if item.FinderID != nil {
-		s.NotifService.CreateNotification(
-			*item.FinderID,
-			"New Claim Received",
-			"Someone has claimed an item you found.",
-			"CLAIM_NEW",
-			claim.ID,
-		)
+		if autoApproved {
+			s.NotifService.CreateNotification(
+				*item.FinderID,
+				"Claim Auto-Approved",
+				"A claim on an item you found answered every question exactly and was approved.",
+				"CLAIM_APPROVED",
+				claim.ID,
+			)
+		} else {
+			s.NotifService.CreateNotification(
+				*item.FinderID,
+				"New Claim Received",
+				"Someone has claimed an item you found.",
+				"CLAIM_NEW",
+				claim.ID,
+			)
+		}
 	}
 
 	// Fetch Claimer Info (User)
 	// Since we have ownerID, we can fetch user or just rely on the fact that we know ID.
 	// But DTO needs Name/Role.
-	// Ideally we should fetch User. But ClaimRepo.Create doesn't return User.
+	// Ideally we should fetch User. But ClaimRepo.CreatePending doesn't return User.
 	// We can fetch claim again with Preload.
 	savedClaim, _ := s.ClaimRepo.FindByID(claim.ID.String())
 	
@@ -463,10 +687,26 @@ func (s *ItemService) GetClaims(itemID string, userID uuid.UUID) ([]models.Claim
 		return nil, errors.New("unauthorized")
 	}
 
-	return s.ClaimRepo.FindByItemID(itemID)
+	claims, err := s.ClaimRepo.FindByItemID(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Claimants' answers are stored encrypted; the finder reads them in plaintext
+	for i := range claims {
+		for j := range claims[i].Answers {
+			if answer, err := utils.Decrypt(claims[i].Answers[j].Answer); err == nil {
+				claims[i].Answers[j].Answer = answer
+			}
+		}
+	}
+	return claims, nil
 }
 
-func (s *ItemService) DecideClaim(claimID string, status string, userID uuid.UUID) error {
+// siblingRejectReason is given to pending claims that lose out when another claim is approved
+const siblingRejectReason = "Another claim for this item was approved."
+
+func (s *ItemService) DecideClaim(claimID string, status string, reason string, userID uuid.UUID) error {
 	// Validate decision
 	if status != "APPROVED" && status != "REJECTED" {
 		return errors.New("invalid decision: must be APPROVED or REJECTED")
@@ -492,36 +732,119 @@ func (s *ItemService) DecideClaim(claimID string, status string, userID uuid.UUI
 		return errors.New("only the finder can decide on claims")
 	}
 
-	claim.Status = models.ClaimStatus(status)
-	if err := s.ClaimRepo.Update(claim); err != nil {
-		return err
-	}
-
-	if status == "APPROVED" {
-		item.Status = models.ItemStatusClaimed
-		s.ItemRepo.Update(item)
+	if status == "REJECTED" {
+		if err := s.ClaimRepo.Reject(claim.ID, models.ClaimStatusPending, reason); err != nil {
+			return err
+		}
 
-		// Notify Owner
+		message := "Your claim has been rejected."
+		if reason != "" {
+			message += " Reason: " + reason
+		}
 		s.NotifService.CreateNotification(
 			claim.OwnerID,
-			"Claim Approved!",
-			"Your claim has been approved. You can now contact the finder.",
-			"CLAIM_APPROVED",
+			"Claim Rejected",
+			message,
+			"CLAIM_REJECTED",
 			claim.ID,
 		)
-	} else {
+		return nil
+	}
+
+	return s.approveClaim(claim, false, lifecycle.Change{Actor: lifecycle.ActorClaim, ActorID: &userID, Reason: "Claim approved by the finder"})
+}
+
+// approveClaim claims the item, rejects the competing claims in one transaction, issues
+// the pickup code and notifies everyone involved. The claim is PENDING, or ESCALATED when
+// a reviewer upholds an appeal. auto marks an approval made on submission, without the
+// finder. change is recorded in the item's status history.
+func (s *ItemService) approveClaim(claim *models.Claim, auto bool, change lifecycle.Change) error {
+	rejected, err := s.ClaimRepo.Approve(claim.ID, claim.Status, auto, siblingRejectReason, change)
+	if err != nil {
+		return err
+	}
+	claim.Status = models.ClaimStatusApproved
+
+	// One-time code the owner shows at handover
+	if _, err := s.Pickups.Issue(claim); err != nil {
+		return err
+	}
+
+	// Notify Owner
+	s.NotifService.CreateNotification(
+		claim.OwnerID,
+		"Claim Approved!",
+		"Your claim has been approved. Open the claim to get your pickup code and show it when you collect the item.",
+		"CLAIM_APPROVED",
+		claim.ID,
+	)
+
+	for _, sibling := range rejected {
 		s.NotifService.CreateNotification(
-			claim.OwnerID,
+			sibling.OwnerID,
 			"Claim Rejected",
-			"Your claim has been rejected.",
+			"Your claim has been rejected. Reason: "+siblingRejectReason,
 			"CLAIM_REJECTED",
-			claim.ID,
+			sibling.ID,
 		)
 	}
 
 	return nil
 }
 
+// scoreClaimAnswers pairs each verification question with the claimant's answer and scores it.
+// Every question must be answered once. allExact reports whether all answers matched exactly.
+func scoreClaimAnswers(verifications []models.ItemVerification, input []dto.ClaimAnswerRequest) ([]models.ClaimAnswer, bool, error) {
+	given := make(map[uuid.UUID]string, len(input))
+	for _, a := range input {
+		if _, dup := given[a.VerificationID]; dup {
+			return nil, false, errors.New("each verification question can only be answered once")
+		}
+		given[a.VerificationID] = a.Answer
+	}
+
+	answers := make([]models.ClaimAnswer, 0, len(verifications))
+	allExact := len(verifications) > 0
+	for _, v := range verifications {
+		text, ok := given[v.ID]
+		if !ok {
+			return nil, false, fmt.Errorf("missing answer for question: %s", v.Question)
+		}
+		delete(given, v.ID)
+
+		expected, err := utils.Decrypt(v.Answer)
+		if err != nil {
+			return nil, false, errors.New("verification answers could not be read")
+		}
+		score, match := matching.ScoreAnswer(expected, text)
+		allExact = allExact && match == models.AnswerMatchExact
+
+		// An exact answer is the stored answer, so it gets the same protection
+		sealed, err := utils.Encrypt(text)
+		if err != nil {
+			return nil, false, err
+		}
+		answers = append(answers, models.ClaimAnswer{
+			VerificationID: v.ID,
+			Question:       v.Question,
+			Answer:         sealed,
+			Score:          score,
+			Match:          match,
+		})
+	}
+	if len(given) > 0 {
+		return nil, false, errors.New("answer refers to a question this item does not have")
+	}
+
+	return answers, allExact, nil
+}
+
+// SealVerificationAnswer normalizes an answer and encrypts it for storage. Fuzzy scoring needs
+// the plaintext back, so answers are encrypted with the server key rather than hashed.
+func SealVerificationAnswer(answer string) (string, error) {
+	return utils.Encrypt(matching.NormalizeAnswer(answer))
+}
+
 func (s *ItemService) UpdateItem(id string, req dto.UpdateItemRequest, userID uuid.UUID) (*dto.ItemResponse, error) {
 	item, err := s.ItemRepo.FindByID(id)
 	if err != nil {
@@ -556,6 +879,12 @@ func (s *ItemService) UpdateItem(id string, req dto.UpdateItemRequest, userID uu
 	// For simplicity, let's just update.
 	item.OfferReward = req.OfferReward
 	item.ShowPhone = req.ShowPhone
+	if req.AutoApprove != nil {
+		if !isFinder {
+			return nil, errors.New("only the finder can change auto-approve")
+		}
+		item.AutoApprove = *req.AutoApprove
+	}
 
 	if req.DateLost != "" {
 		dateLost, err := time.Parse("2006-01-02", req.DateLost)
@@ -598,34 +927,80 @@ func (s *ItemService) UpdateItem(id string, req dto.UpdateItemRequest, userID uu
 	return s.GetItem(id, userID)
 }
 
-func (s *ItemService) UpdateItemStatus(id string, status string, userID uuid.UUID) (*dto.ItemResponse, error) {
+// UpdateItemStatus is a manual status change by the finder, the owner or security staff,
+// checked against the item state machine
+func (s *ItemService) UpdateItemStatus(id string, req dto.UpdateItemStatusRequest, userID uuid.UUID, role string) (*dto.ItemResponse, error) {
 	item, err := s.ItemRepo.FindByID(id)
 	if err != nil {
-		return nil, err
+		return nil, errors.New("item not found")
 	}
 
 	// Authorization
-	isFinder := item.FinderID != nil && *item.FinderID == userID
-	isOwner := item.OwnerID != nil && *item.OwnerID == userID
-	if !isFinder && !isOwner {
+	actors := lifecycle.ActorsFor(item, userID, role)
+	if len(actors) == 0 {
 		return nil, errors.New("unauthorized")
 	}
 
-	item.Status = models.ItemStatus(status)
-	if err := s.ItemRepo.Update(item); err != nil {
+	from, to := item.Status, models.ItemStatus(req.Status)
+	actor, err := lifecycle.Pick(from, to, actors)
+	if err != nil {
 		return nil, err
 	}
+	if err := s.ItemRepo.Transition(item, to, lifecycle.Change{Actor: actor, ActorID: &userID, Reason: req.Reason}); err != nil {
+		return nil, err
+	}
+	// A reopened item gets a full retention period again
+	if from == models.ItemStatusExpired && to == models.ItemStatusOpen {
+		s.ItemRepo.RestartRetention(item.ID)
+	}
 
 	return s.GetItem(id, userID)
 }
 
-func (s *ItemService) GetUserItems(userID uuid.UUID) ([]dto.ItemResponse, error) {
-	items, err := s.ItemRepo.FindByUserID(userID.String())
+// GetStatusHistory returns an item's audited status changes (finder, owner or security)
+func (s *ItemService) GetStatusHistory(id string, userID uuid.UUID, role string) ([]dto.ItemStatusHistoryResponse, error) {
+	item, err := s.ItemRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("item not found")
+	}
+	if len(lifecycle.ActorsFor(item, userID, role)) == 0 {
+		return nil, errors.New("unauthorized")
+	}
+
+	history, err := s.ItemRepo.FindStatusHistory(id)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := []dto.ItemStatusHistoryResponse{}
+	for _, h := range history {
+		resp := dto.ItemStatusHistoryResponse{
+			ID:         h.ID,
+			FromStatus: string(h.FromStatus),
+			ToStatus:   string(h.ToStatus),
+			Actor:      h.Actor,
+			ActorID:    h.ActorID,
+			Reason:     h.Reason,
+			CreatedAt:  h.CreatedAt,
+		}
+		if h.ActorUser != nil {
+			resp.ActorName = h.ActorUser.Name
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+func (s *ItemService) GetUserItems(userID uuid.UUID, page pagination.Params) (*dto.Page, error) {
+	items, total, err := s.ItemRepo.FindByUserID(userID.String(), page)
 	if err != nil {
 		return nil, err
 	}
+	items, nextCursor := pagination.Trim(items, page, func(item models.Item) (string, uuid.UUID) {
+		return pagination.TimeValue(item.CreatedAt), item.ID
+	})
 
-	var itemResponses []dto.ItemResponse
+	itemResponses := []dto.ItemResponse{}
 	for _, item := range items {
 		// Map to DTO (Simplified mapping, reuse logic if possible)
 		// ... (Copy mapping logic from GetAllItems or extract to helper)
@@ -661,12 +1036,11 @@ func (s *ItemService) GetUserItems(userID uuid.UUID) ([]dto.ItemResponse, error)
 		itemResponses = append(itemResponses, resp)
 	}
 	
-	// Sort
-	sort.Slice(itemResponses, func(i, j int) bool {
-		return itemResponses[i].CreatedAt.After(itemResponses[j].CreatedAt)
-	})
-
-	return itemResponses, nil
+	return &dto.Page{
+		Data:       itemResponses,
+		NextCursor: nextCursor,
+		Total:      total,
+	}, nil
 }
 
 func (s *ItemService) DeleteItem(id string, userID uuid.UUID) error {
@@ -685,3 +1059,33 @@ func (s *ItemService) DeleteItem(id string, userID uuid.UUID) error {
 
 	return s.ItemRepo.Delete(id)
 }
+
+// Search runs a full-text search over items and lost-mode assets, best match first
+func (s *ItemService) Search(filter repository.SearchFilter) ([]dto.SearchResultResponse, error) {
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 20
+	}
+
+	rows, err := s.ItemRepo.Search(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []dto.SearchResultResponse{}
+	for _, row := range rows {
+		results = append(results, dto.SearchResultResponse{
+			Kind:       row.Kind,
+			ID:         row.ID,
+			Title:      row.Title,
+			Type:       row.Type,
+			Status:     row.Status,
+			CategoryID: row.CategoryID,
+			LocationID: row.LocationID,
+			Urgency:    row.Urgency,
+			OccurredAt: row.OccurredAt,
+			Rank:       row.Rank,
+			Snippet:    row.Snippet,
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/matching"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/repository"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+type MatchService struct {
+	MatchRepo   *repository.MatchRepository
+	ItemService *ItemService
+}
+
+func NewMatchService(matchRepo *repository.MatchRepository, itemService *ItemService) *MatchService {
+	return &MatchService{
+		MatchRepo:   matchRepo,
+		ItemService: itemService,
+	}
+}
+
+// GetMatches returns the suggestions for a lost item, lost asset or found item
+// that the user is a party to (as owner of the lost report or finder of the found item)
+func (s *MatchService) GetMatches(reportID string, status string, userID uuid.UUID) ([]dto.MatchResponse, error) {
+	matches, err := s.MatchRepo.FindByReportID(reportID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := []dto.MatchResponse{}
+	for _, match := range matches {
+		isOwner := match.OwnerID == userID
+		isFinder := match.FinderID != nil && *match.FinderID == userID
+		if !isOwner && !isFinder {
+			continue
+		}
+		responses = append(responses, toMatchResponse(&match))
+	}
+	return responses, nil
+}
+
+// UpdateMatch accepts or dismisses a suggestion (lost report owner only).
+// Accepting submits a claim on the found item prefilled from the lost report. The match is
+// decided first, conditional on it still being a suggestion, so it cannot end up with two
+// claims; if the claim is refused the match becomes a suggestion again.
+func (s *MatchService) UpdateMatch(id string, req dto.UpdateMatchRequest, userID uuid.UUID) (*dto.MatchResponse, error) {
+	match, err := s.MatchRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("match not found")
+	}
+
+	if match.OwnerID != userID {
+		return nil, errors.New("unauthorized")
+	}
+
+	if match.Status != models.MatchStatusSuggested {
+		return nil, errors.New("match has already been decided")
+	}
+
+	status := models.MatchStatus(req.Status)
+	var claimID *uuid.UUID
+	if status == models.MatchStatusAccepted {
+		newID := uuid.New()
+		claimID = &newID
+	}
+	decided, err := s.MatchRepo.Decide(match.ID, status, claimID)
+	if err != nil {
+		return nil, err
+	}
+	if !decided {
+		return nil, errors.New("match has already been decided")
+	}
+
+	if claimID != nil {
+		// The private image of a lost asset is proof for the owner's claims and is never
+		// offered to the finder; the owner attaches an image explicitly if they want to
+		claimReq := dto.CreateClaimRequest{
+			Answers:     req.Answers,
+			AnswerInput: req.AnswerInput,
+			ImageURL:    req.ImageURL,
+		}
+		if match.LostItem != nil {
+			if claimReq.AnswerInput == "" {
+				claimReq.AnswerInput = match.LostItem.Title + ". " + match.LostItem.Description
+			}
+			if claimReq.ImageURL == "" {
+				claimReq.ImageURL = match.LostItem.ImageURL
+			}
+		} else if match.LostAsset != nil {
+			if claimReq.AnswerInput == "" {
+				claimReq.AnswerInput = match.LostAsset.Description
+			}
+		}
+
+		if _, err := s.ItemService.submitClaim(match.FoundItemID.String(), claimReq, userID, *claimID); err != nil {
+			if reopenErr := s.MatchRepo.Reopen(match.ID, *claimID); reopenErr != nil {
+				log.Printf("matches: could not reopen match %s after a refused claim: %v", match.ID, reopenErr)
+			}
+			return nil, err
+		}
+	}
+
+	match.Status = status
+	match.ClaimID = claimID
+	resp := toMatchResponse(match)
+	return &resp, nil
+}
+
+func toMatchResponse(match *models.Match) dto.MatchResponse {
+	resp := dto.MatchResponse{
+		ID:          match.ID,
+		LostItemID:  match.LostItemID,
+		LostAssetID: match.LostAssetID,
+		FoundItemID: match.FoundItemID,
+		FoundTitle:  match.FoundItem.Title,
+		Score:       match.Score,
+		Breakdown:   match.Breakdown,
+		Explanation: matching.Explain(match.Breakdown),
+		Status:      string(match.Status),
+		ClaimID:     match.ClaimID,
+		CreatedAt:   match.CreatedAt,
+	}
+	if match.LostItem != nil {
+		resp.LostTitle = match.LostItem.Title
+	} else if match.LostAsset != nil {
+		resp.LostTitle = match.LostAsset.Description
+	}
+	return resp
+}
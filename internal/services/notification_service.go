@@ -1,8 +1,12 @@
 package services
 
 import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/jobs"
 	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
 	"campus-lost-and-found/internal/repository"
+	"encoding/json"
 
 	"github.com/google/uuid"
 )
@@ -26,10 +30,49 @@ func (s *NotificationService) CreateNotification(userID uuid.UUID, title, body,
 	return s.Repo.Create(notification)
 }
 
-func (s *NotificationService) GetUserNotifications(userID string) ([]models.Notification, error) {
-	return s.Repo.FindByUserID(userID)
+func (s *NotificationService) GetUserNotifications(userID string, page pagination.Params) (*dto.Page, error) {
+	notifications, total, err := s.Repo.FindByUserID(userID, page)
+	if err != nil {
+		return nil, err
+	}
+	notifications, nextCursor := pagination.Trim(notifications, page, func(n models.Notification) (string, uuid.UUID) {
+		return pagination.TimeValue(n.CreatedAt), n.ID
+	})
+	if notifications == nil {
+		notifications = []models.Notification{}
+	}
+	return &dto.Page{Data: notifications, NextCursor: nextCursor, Total: total}, nil
 }
 
 func (s *NotificationService) MarkAsRead(id string) error {
 	return s.Repo.MarkAsRead(id)
 }
+
+// HandleSendNotification is the job handler for queued notifications
+func (s *NotificationService) HandleSendNotification(payload []byte) error {
+	var p jobs.NotificationPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return err
+	}
+	return s.CreateNotification(p.UserID, p.Title, p.Body, p.RefType, p.RefID)
+}
+
+// QueuedNotifier has the same CreateNotification signature as NotificationService
+// but only enqueues a job, for fan-out (e.g. from the matching engine)
+type QueuedNotifier struct {
+	Jobs *jobs.Runner
+}
+
+func NewQueuedNotifier(runner *jobs.Runner) *QueuedNotifier {
+	return &QueuedNotifier{Jobs: runner}
+}
+
+func (n *QueuedNotifier) CreateNotification(userID uuid.UUID, title, body, refType string, refID uuid.UUID) error {
+	return n.Jobs.Enqueue(jobs.TypeSendNotification, jobs.NotificationPayload{
+		UserID:  userID,
+		Title:   title,
+		Body:    body,
+		RefType: refType,
+		RefID:   refID,
+	})
+}
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"campus-lost-and-found/config"
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/lifecycle"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/repository"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/skip2/go-qrcode"
+)
+
+// pickupQRPrefix marks QR content as a pickup code: PICKUP:<item id>:<code>
+const pickupQRPrefix = "PICKUP:"
+
+// PickupService confirms handovers: an approved claimant gets a one-time code, and the
+// finder or a security officer enters or scans it when the item changes hands
+type PickupService struct {
+	PickupRepo   *repository.PickupRepository
+	ItemRepo     *repository.ItemRepository
+	ClaimRepo    *repository.ClaimRepository
+	AssetRepo    *repository.AssetRepository
+	MatchRepo    *repository.MatchRepository
+	CustodyRepo  *repository.CustodyRepository
+	NotifService *NotificationService
+}
+
+func NewPickupService(pickupRepo *repository.PickupRepository, itemRepo *repository.ItemRepository, claimRepo *repository.ClaimRepository, assetRepo *repository.AssetRepository, matchRepo *repository.MatchRepository, custodyRepo *repository.CustodyRepository, notifService *NotificationService) *PickupService {
+	return &PickupService{
+		PickupRepo:   pickupRepo,
+		ItemRepo:     itemRepo,
+		ClaimRepo:    claimRepo,
+		AssetRepo:    assetRepo,
+		MatchRepo:    matchRepo,
+		CustodyRepo:  custodyRepo,
+		NotifService: notifService,
+	}
+}
+
+// Issue creates a fresh code for an approved claim, replacing any earlier one
+func (s *PickupService) Issue(claim *models.Claim) (*models.PickupCode, error) {
+	code, err := generatePickupCode()
+	if err != nil {
+		return nil, err
+	}
+
+	pickup := &models.PickupCode{
+		ClaimID:     claim.ID,
+		ItemID:      claim.ItemID,
+		Code:        code,
+		MaxAttempts: config.AppConfig.PickupCodeMaxAttempts,
+		ExpiresAt:   time.Now().Add(config.AppConfig.PickupCodeTTL),
+	}
+	if err := s.PickupRepo.Save(pickup); err != nil {
+		return nil, err
+	}
+	return pickup, nil
+}
+
+// GetCode shows the claimant their code and its QR
+func (s *PickupService) GetCode(claimID string, userID uuid.UUID) (*dto.PickupCodeResponse, error) {
+	if _, err := s.awaitingPickup(claimID, userID); err != nil {
+		return nil, err
+	}
+
+	pickup, err := s.PickupRepo.FindByClaimID(claimID)
+	if err != nil {
+		return nil, errors.New("pickup code not found")
+	}
+	if time.Now().After(pickup.ExpiresAt) {
+		return nil, errors.New("pickup code has expired, request a new one")
+	}
+	if pickup.Attempts >= pickup.MaxAttempts {
+		return nil, errors.New("pickup code is locked after too many wrong attempts, request a new one")
+	}
+
+	return toPickupCodeResponse(pickup)
+}
+
+// Regenerate replaces an expired or locked code (claimant only)
+func (s *PickupService) Regenerate(claimID string, userID uuid.UUID) (*dto.PickupCodeResponse, error) {
+	claim, err := s.awaitingPickup(claimID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pickup, err := s.Issue(claim)
+	if err != nil {
+		return nil, err
+	}
+	return toPickupCodeResponse(pickup)
+}
+
+// Handover checks the claimant's code and closes the case: the item is resolved, the claim
+// completed and the owner's lost report (if the claim came from a match) resolved too.
+// Items held at a security desk can only be handed over by security and are checked out
+// of custody on the way.
+func (s *PickupService) Handover(itemID string, req dto.HandoverRequest, actorID uuid.UUID, role string) (*dto.HandoverResponse, error) {
+	item, err := s.ItemRepo.FindByID(itemID)
+	if err != nil {
+		return nil, errors.New("item not found")
+	}
+
+	isFinder := item.FinderID != nil && *item.FinderID == actorID
+	isSecurity := role == string(models.RoleSecurity) || role == string(models.RoleAdmin)
+	if !isFinder && !isSecurity {
+		return nil, errors.New("only the finder or security can confirm a handover")
+	}
+
+	custody, err := s.CustodyRepo.FindActiveByItemID(itemID)
+	if err != nil {
+		return nil, err
+	}
+	if custody != nil && !isSecurity {
+		return nil, errors.New("item is kept at a security desk, security must confirm the handover")
+	}
+
+	if item.Status != models.ItemStatusClaimed {
+		return nil, errors.New("item has no approved claim awaiting pickup")
+	}
+	claim, err := s.ClaimRepo.FindApprovedByItemID(itemID)
+	if err != nil {
+		return nil, errors.New("item has no approved claim awaiting pickup")
+	}
+	pickup, err := s.PickupRepo.FindByClaimID(claim.ID.String())
+	if err != nil {
+		return nil, errors.New("pickup code not found")
+	}
+
+	if pickup.UsedAt != nil {
+		return nil, errors.New("pickup code has already been used")
+	}
+	if time.Now().After(pickup.ExpiresAt) {
+		return nil, errors.New("pickup code has expired, the claimant must request a new one")
+	}
+
+	// Malformed input (e.g. another item's QR) is not a guess and does not use up an attempt
+	code, err := parsePickupInput(req.Code, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	counted, err := s.PickupRepo.RecordAttempt(pickup.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !counted {
+		return nil, errors.New("pickup code is locked after too many wrong attempts, the claimant must request a new one")
+	}
+	if subtle.ConstantTimeCompare([]byte(code), []byte(pickup.Code)) != 1 {
+		return nil, errors.New("invalid pickup code")
+	}
+
+	handover := repository.Handover{PickupID: &pickup.ID, ActorID: actorID, Item: item, Claim: claim}
+	if custody != nil {
+		now := time.Now()
+		custody.CheckedOutAt = &now
+		custody.CheckedOutToID = &claim.OwnerID
+		handover.Custody = custody
+		handover.CustodyEvent = &models.CustodyEvent{
+			Type:        models.CustodyEventCheckOut,
+			DeskID:      custody.DeskID,
+			StorageBin:  custody.StorageBin,
+			ActorID:     actorID,
+			RecipientID: &claim.OwnerID,
+			Note:        req.Note,
+		}
+	}
+	if err := s.Complete(handover); err != nil {
+		return nil, err
+	}
+
+	return &dto.HandoverResponse{
+		ItemID:         item.ID,
+		ItemStatus:     string(item.Status),
+		ClaimID:        claim.ID,
+		ClaimStatus:    string(claim.Status),
+		ClaimantID:     claim.OwnerID,
+		HandedOverByID: actorID,
+		HandedOverAt:   time.Now(),
+	}, nil
+}
+
+// Complete moves a handed over item and its claim to their final state, together with the
+// code and custody check-out in the handover, and tells both sides. Also used by the security
+// desk check-out, which verifies the owner by identity number.
+func (s *PickupService) Complete(handover repository.Handover) error {
+	handover.Change = lifecycle.Change{Actor: lifecycle.ActorClaim, ActorID: &handover.ActorID, Reason: "Handed over to the claimant"}
+	handover.SignEvent = signCustodyEvent
+	if err := s.PickupRepo.CompleteHandover(handover); err != nil {
+		return err
+	}
+	item, claim := handover.Item, handover.Claim
+
+	// The item is already handed over, so a failure here is logged rather than undoing the handover
+	if err := s.resolveLostReport(claim); err != nil {
+		log.Printf("pickup: could not resolve the lost report of claim %s: %v", claim.ID, err)
+	}
+
+	s.NotifService.CreateNotification(
+		claim.OwnerID,
+		"Item Handed Over",
+		fmt.Sprintf("You have received '%s'. Your claim is now complete.", item.Title),
+		"CLAIM_COMPLETED",
+		claim.ID,
+	)
+	if item.FinderID != nil {
+		s.NotifService.CreateNotification(
+			*item.FinderID,
+			"Item Returned",
+			fmt.Sprintf("The item '%s' you found has been returned to its owner. Thank you!", item.Title),
+			"CLAIM_COMPLETED",
+			item.ID,
+		)
+	}
+	return nil
+}
+
+// resolveLostReport closes the owner's lost item or lost asset the claim was matched from
+func (s *PickupService) resolveLostReport(claim *models.Claim) error {
+	match, err := s.MatchRepo.FindByClaimID(claim.ID.String())
+	if err != nil {
+		return err
+	}
+	if match == nil {
+		return nil
+	}
+
+	if match.LostItemID != nil {
+		lostItem, err := s.ItemRepo.FindByID(match.LostItemID.String())
+		if err != nil {
+			return err
+		}
+		if lostItem.Status == models.ItemStatusOpen {
+			err := s.ItemRepo.Transition(lostItem, models.ItemStatusResolved, lifecycle.Change{
+				Actor:   lifecycle.ActorClaim,
+				ActorID: &claim.OwnerID,
+				Reason:  "Returned through a matched found item",
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if match.LostAssetID != nil {
+		asset, err := s.AssetRepo.FindByID(match.LostAssetID.String())
+		if err != nil {
+			return err
+		}
+		episode, err := s.AssetRepo.FindOpenLostEpisode(asset.ID.String())
+		if err != nil {
+			return err
+		}
+		if episode != nil {
+			now := time.Now()
+			episode.EndedAt = &now
+			if err := s.AssetRepo.UpdateLostEpisode(episode); err != nil {
+				return err
+			}
+		}
+		if asset.LostMode {
+			asset.LostMode = false
+			if err := s.AssetRepo.Update(asset); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// awaitingPickup loads a claim the user owns that is approved and not yet handed over
+func (s *PickupService) awaitingPickup(claimID string, userID uuid.UUID) (*models.Claim, error) {
+	claim, err := s.ClaimRepo.FindByID(claimID)
+	if err != nil {
+		return nil, errors.New("claim not found")
+	}
+	if claim.OwnerID != userID {
+		return nil, errors.New("only the claimant can view the pickup code")
+	}
+	if claim.Status != models.ClaimStatusApproved {
+		return nil, errors.New("claim is not awaiting pickup")
+	}
+	return claim, nil
+}
+
+// parsePickupInput accepts the typed code or the scanned QR content
+func parsePickupInput(input string, itemID uuid.UUID) (string, error) {
+	input = strings.TrimSpace(input)
+	if !strings.HasPrefix(input, pickupQRPrefix) {
+		return input, nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(input, pickupQRPrefix), ":")
+	if len(parts) != 2 {
+		return "", errors.New("invalid pickup code")
+	}
+	if parts[0] != itemID.String() {
+		return "", errors.New("pickup code belongs to a different item")
+	}
+	return parts[1], nil
+}
+
+func generatePickupCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func toPickupCodeResponse(pickup *models.PickupCode) (*dto.PickupCodeResponse, error) {
+	png, err := qrcode.Encode(fmt.Sprintf("%s%s:%s", pickupQRPrefix, pickup.ItemID, pickup.Code), qrcode.Medium, 256)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PickupCodeResponse{
+		ClaimID:      pickup.ClaimID,
+		ItemID:       pickup.ItemID,
+		Code:         pickup.Code,
+		QRCode:       "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+		ExpiresAt:    pickup.ExpiresAt,
+		AttemptsLeft: pickup.MaxAttempts - pickup.Attempts,
+	}, nil
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"campus-lost-and-found/config"
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
+	"campus-lost-and-found/internal/repository"
+	"campus-lost-and-found/internal/utils"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Public previews are small and blurred enough to recognise the kind of item but not the
+// details a claimant is asked about
+const (
+	thumbnailSize       = 240
+	thumbnailBlurRadius = 3
+)
+
+// PublicService serves the read-only feed for visitors without an account, through the
+// redacted dto.PublicItemResponse
+type PublicService struct {
+	ItemRepo  *repository.ItemRepository
+	AssetRepo *repository.AssetRepository
+}
+
+func NewPublicService(itemRepo *repository.ItemRepository, assetRepo *repository.AssetRepository) *PublicService {
+	return &PublicService{
+		ItemRepo:  itemRepo,
+		AssetRepo: assetRepo,
+	}
+}
+
+// GetFeed returns one page of OPEN items and lost assets, newest first by default
+func (s *PublicService) GetFeed(itemType string, page pagination.Params) (*dto.Page, error) {
+	rows, total, err := s.ItemRepo.FindFeed(string(models.ItemStatusOpen), itemType, page)
+	if err != nil {
+		return nil, err
+	}
+	rows, nextCursor := pagination.Trim(rows, page, func(row repository.FeedRow) (string, uuid.UUID) {
+		return pagination.TimeValue(row.SortTime), row.ID
+	})
+
+	var itemIDs, assetIDs []uuid.UUID
+	for _, row := range rows {
+		if row.Kind == "ASSET" {
+			assetIDs = append(assetIDs, row.ID)
+		} else {
+			itemIDs = append(itemIDs, row.ID)
+		}
+	}
+
+	items, err := s.ItemRepo.FindByIDs(itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	itemsByID := make(map[uuid.UUID]models.Item, len(items))
+	for _, item := range items {
+		itemsByID[item.ID] = item
+	}
+
+	assets, err := s.AssetRepo.FindLostByIDs(assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	assetsByID := make(map[uuid.UUID]models.Asset, len(assets))
+	for _, asset := range assets {
+		assetsByID[asset.ID] = asset
+	}
+
+	responses := []dto.PublicItemResponse{}
+	for _, row := range rows {
+		if row.Kind == "ASSET" {
+			if asset, ok := assetsByID[row.ID]; ok {
+				responses = append(responses, publicAssetResponse(&asset))
+			}
+		} else if item, ok := itemsByID[row.ID]; ok {
+			responses = append(responses, publicItemResponse(&item))
+		}
+	}
+
+	return &dto.Page{Data: responses, NextCursor: nextCursor, Total: total}, nil
+}
+
+// GetItem returns the public view of an item, or of an asset in lost mode
+func (s *PublicService) GetItem(id string) (*dto.PublicItemResponse, error) {
+	if item, err := s.ItemRepo.FindPublicByID(id); err == nil {
+		if !publiclyVisible(item) {
+			return nil, errors.New("item not found")
+		}
+		resp := publicItemResponse(item)
+		return &resp, nil
+	}
+
+	assetID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.New("item not found")
+	}
+	assets, err := s.AssetRepo.FindLostByIDs([]uuid.UUID{assetID})
+	if err != nil || len(assets) == 0 || !assets[0].LostMode {
+		return nil, errors.New("item not found")
+	}
+	resp := publicAssetResponse(&assets[0])
+	return &resp, nil
+}
+
+// GetThumbnail returns the path of an item's blurred preview, generating it on first use.
+// Only images uploaded to this server have one; remote URLs are never fetched.
+func (s *PublicService) GetThumbnail(id string) (string, error) {
+	item, err := s.ItemRepo.FindPublicByID(id)
+	if err != nil || !publiclyVisible(item) {
+		return "", errors.New("item not found")
+	}
+	source := localUploadPath(item.ImageURL)
+	if source == "" {
+		return "", errors.New("item has no preview")
+	}
+
+	sum := sha1.Sum([]byte(item.ImageURL))
+	thumbDir := filepath.Join(config.AppConfig.UploadPath, "thumbs")
+	thumbPath := filepath.Join(thumbDir, hex.EncodeToString(sum[:8])+".jpg")
+	if _, err := os.Stat(thumbPath); err == nil {
+		return thumbPath, nil
+	}
+
+	f, err := os.Open(source)
+	if err != nil {
+		return "", errors.New("item has no preview")
+	}
+	defer f.Close()
+
+	data, err := utils.BlurredThumbnail(f, thumbnailSize, thumbnailBlurRadius)
+	if err != nil {
+		return "", errors.New("item has no preview")
+	}
+	if err := os.MkdirAll(thumbDir, 0755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(thumbPath, data, 0644); err != nil {
+		return "", err
+	}
+	return thumbPath, nil
+}
+
+// publiclyVisible hides items that are closed for good or waiting for disposal
+func publiclyVisible(item *models.Item) bool {
+	return item.Status == models.ItemStatusOpen || item.Status == models.ItemStatusClaimed
+}
+
+// localUploadPath maps an /uploads/ URL to the file on disk, or "" for anything else
+func localUploadPath(imageURL string) string {
+	name, ok := strings.CutPrefix(imageURL, "/uploads/")
+	if !ok || name == "" {
+		return ""
+	}
+	return filepath.Join(config.AppConfig.UploadPath, filepath.Base(name))
+}
+
+func publicItemResponse(item *models.Item) dto.PublicItemResponse {
+	resp := dto.PublicItemResponse{
+		ID:           item.ID,
+		Kind:         "ITEM",
+		Title:        item.Title,
+		Type:         string(item.Type),
+		Description:  item.Description,
+		CategoryID:   item.CategoryID,
+		CategoryName: item.Category.Name,
+		Status:       string(item.Status),
+		OfferReward:  item.OfferReward,
+		CreatedAt:    item.CreatedAt,
+	}
+	if item.Type == models.ItemTypeLost {
+		resp.Urgency = string(item.Urgency)
+	}
+	if item.Location != nil {
+		resp.LocationName = item.Location.Name
+	} else if item.LocationDescription != "" {
+		resp.LocationName = item.LocationDescription
+	}
+	if item.DateLost != nil {
+		resp.DateLost = item.DateLost.Format("2006-01-02")
+	}
+	if item.DateFound != nil {
+		resp.DateFound = item.DateFound.Format("2006-01-02")
+	}
+	if localUploadPath(item.ImageURL) != "" {
+		resp.ThumbnailURL = fmt.Sprintf("/api/v1/public/items/%s/thumbnail", item.ID)
+	}
+	if item.ShowPhone {
+		for _, c := range item.Contacts {
+			resp.Contacts = append(resp.Contacts, dto.ContactResponse{
+				Platform: string(c.Platform),
+				Value:    c.Value,
+			})
+		}
+	}
+	return resp
+}
+
+// publicAssetResponse shows a lost-mode asset without its private image or owner
+func publicAssetResponse(asset *models.Asset) dto.PublicItemResponse {
+	resp := dto.PublicItemResponse{
+		ID:           asset.ID,
+		Kind:         "ASSET",
+		Title:        asset.Description,
+		Type:         string(models.ItemTypeLost),
+		Description:  asset.Description,
+		CategoryID:   asset.CategoryID,
+		CategoryName: asset.Category.Name,
+		Status:       string(models.ItemStatusOpen),
+		CreatedAt:    asset.UpdatedAt,
+		DateLost:     asset.UpdatedAt.Format("2006-01-02"),
+	}
+	if len(asset.LostEpisodes) > 0 {
+		episode := asset.LostEpisodes[0]
+		resp.CreatedAt = episode.LostSince
+		resp.DateLost = episode.LostSince.Format("2006-01-02")
+		resp.LocationName = episodeLocationName(&episode)
+	}
+	return resp
+}
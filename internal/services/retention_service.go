@@ -0,0 +1,207 @@
+package services
+
+import (
+	"campus-lost-and-found/config"
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/lifecycle"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/repository"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RetentionService applies the campus retention policy: unclaimed found items are kept for
+// their category's retention period, the finder is reminded before it ends, and expired
+// items wait in a disposal queue until security donates, disposes of or transfers them
+type RetentionService struct {
+	ItemRepo     *repository.ItemRepository
+	ClaimRepo    *repository.ClaimRepository
+	CustodyRepo  *repository.CustodyRepository
+	NotifService *NotificationService
+}
+
+func NewRetentionService(itemRepo *repository.ItemRepository, claimRepo *repository.ClaimRepository, custodyRepo *repository.CustodyRepository, notifService *NotificationService) *RetentionService {
+	return &RetentionService{
+		ItemRepo:     itemRepo,
+		ClaimRepo:    claimRepo,
+		CustodyRepo:  custodyRepo,
+		NotifService: notifService,
+	}
+}
+
+// RetentionDays is the category's rule, or ITEM_RETENTION_DAYS when it has none
+func RetentionDays(category *models.ItemCategory) int {
+	if category.RetentionDays > 0 {
+		return category.RetentionDays
+	}
+	return config.AppConfig.RetentionDefaultDays
+}
+
+// RetentionDeadline is when an unclaimed found item expires. The item's category must be loaded.
+func RetentionDeadline(item *models.Item) time.Time {
+	start := item.CreatedAt
+	if item.RetentionStartedAt != nil {
+		start = *item.RetentionStartedAt
+	}
+	return start.AddDate(0, 0, RetentionDays(&item.Category))
+}
+
+// HandleRetentionSweep is the periodic job that reminds finders of items about to expire and
+// moves items past their retention period to EXPIRED. Items with claims still waiting for a
+// decision are left alone until those are decided.
+func (s *RetentionService) HandleRetentionSweep(payload []byte) error {
+	items, err := s.ItemRepo.FindRetentionCandidates()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	reminderWindow := time.Duration(config.AppConfig.RetentionReminderDays) * 24 * time.Hour
+	for i := range items {
+		item := &items[i]
+		deadline := RetentionDeadline(item)
+
+		if now.After(deadline) {
+			if pending, err := s.ClaimRepo.HasOpenClaims(item.ID); err != nil || pending {
+				continue
+			}
+			change := lifecycle.Change{
+				Actor:  lifecycle.ActorSystem,
+				Reason: fmt.Sprintf("Unclaimed after the %d-day retention period", RetentionDays(&item.Category)),
+			}
+			if err := s.ItemRepo.Transition(item, models.ItemStatusExpired, change); err != nil {
+				log.Printf("retention: could not expire item %s: %v", item.ID, err)
+				continue
+			}
+			if item.FinderID != nil {
+				s.NotifService.CreateNotification(
+					*item.FinderID,
+					"Item Expired",
+					fmt.Sprintf("No one has claimed '%s'. It now goes to campus security to be donated or disposed of.", item.Title),
+					"ITEM_EXPIRED",
+					item.ID,
+				)
+			}
+			continue
+		}
+
+		if item.ExpiryRemindedAt == nil && now.After(deadline.Add(-reminderWindow)) {
+			if item.FinderID != nil {
+				s.NotifService.CreateNotification(
+					*item.FinderID,
+					"Item Expiring Soon",
+					fmt.Sprintf("'%s' has not been claimed and will expire on %s.", item.Title, deadline.Format("2006-01-02")),
+					"ITEM_EXPIRING",
+					item.ID,
+				)
+			}
+			s.ItemRepo.MarkExpiryReminded(item.ID)
+		}
+	}
+	return nil
+}
+
+// GetDisposalQueue lists expired found items with where security keeps them
+func (s *RetentionService) GetDisposalQueue() ([]dto.DisposalQueueResponse, error) {
+	items, err := s.ItemRepo.FindExpired()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := []dto.DisposalQueueResponse{}
+	for i := range items {
+		item := &items[i]
+		resp := dto.DisposalQueueResponse{
+			ItemID:           item.ID,
+			Title:            item.Title,
+			CategoryName:     item.Category.Name,
+			RetentionDays:    RetentionDays(&item.Category),
+			RetentionEndedAt: RetentionDeadline(item),
+			ReportedAt:       item.CreatedAt,
+		}
+		if item.Location != nil {
+			resp.LocationName = item.Location.Name
+		}
+		if item.Finder != nil {
+			resp.FinderName = item.Finder.Name
+		}
+		if custody, err := s.CustodyRepo.FindActiveByItemID(item.ID.String()); err == nil && custody != nil {
+			resp.DeskID = &custody.DeskID
+			resp.DeskName = custody.Desk.Name
+			resp.StorageBin = custody.StorageBin
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+// Dispose records the final disposition of an expired item and archives it. An item held
+// at a security desk leaves custody with a signed DISPOSE event.
+func (s *RetentionService) Dispose(req dto.DisposeItemRequest, actorID uuid.UUID) (*dto.DisposalResponse, error) {
+	item, err := s.ItemRepo.FindByID(req.ItemID.String())
+	if err != nil {
+		return nil, errors.New("item not found")
+	}
+	if item.Status != models.ItemStatusExpired {
+		return nil, errors.New("item has not expired")
+	}
+
+	disposal := &models.Disposal{
+		Disposition:  models.Disposition(req.Disposition),
+		Recipient:    strings.TrimSpace(req.Recipient),
+		Note:         req.Note,
+		RecordedByID: actorID,
+	}
+	reason := req.Disposition
+	if disposal.Recipient != "" {
+		reason += " to " + disposal.Recipient
+	}
+	change := lifecycle.Change{Actor: lifecycle.ActorSecurity, ActorID: &actorID, Reason: reason}
+
+	custody, err := s.CustodyRepo.FindActiveByItemID(item.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	var event *models.CustodyEvent
+	if custody != nil {
+		now := time.Now()
+		custody.CheckedOutAt = &now
+		event = &models.CustodyEvent{
+			Type:       models.CustodyEventDispose,
+			DeskID:     custody.DeskID,
+			StorageBin: custody.StorageBin,
+			ActorID:    actorID,
+			Note:       reason,
+		}
+	}
+	if err := s.ItemRepo.Dispose(item, disposal, change, custody, event, signCustodyEvent); err != nil {
+		return nil, err
+	}
+
+	if item.FinderID != nil {
+		s.NotifService.CreateNotification(
+			*item.FinderID,
+			"Item Disposed",
+			fmt.Sprintf("'%s' was never claimed and has been %s by campus security.", item.Title, strings.ToLower(req.Disposition)),
+			"ITEM_DISPOSED",
+			item.ID,
+		)
+	}
+
+	return &dto.DisposalResponse{
+		ID:           disposal.ID,
+		ItemID:       item.ID,
+		ItemTitle:    item.Title,
+		ItemStatus:   string(item.Status),
+		Disposition:  string(disposal.Disposition),
+		Recipient:    disposal.Recipient,
+		Note:         disposal.Note,
+		RecordedByID: disposal.RecordedByID,
+		CreatedAt:    disposal.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/matching"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/repository"
+	"campus-lost-and-found/internal/utils"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// scanChallengeTTL is how long a finder has to answer the challenge shown on the scan page
+const scanChallengeTTL = 10 * time.Minute
+
+// ScanService backs the page behind an asset's QR sticker, for finders who have no account
+type ScanService struct {
+	AssetRepo *repository.AssetRepository
+	EnumRepo  *repository.EnumerationRepository
+	Assets    *AssetService
+}
+
+func NewScanService(assetRepo *repository.AssetRepository, enumRepo *repository.EnumerationRepository, assets *AssetService) *ScanService {
+	return &ScanService{
+		AssetRepo: assetRepo,
+		EnumRepo:  enumRepo,
+		Assets:    assets,
+	}
+}
+
+// GetScan tells the finder what they are holding and where to bring it, given the token from
+// the QR code. With the scanner's coordinates the security desks are sorted by distance and
+// the nearest one is picked out.
+func (s *ScanService) GetScan(assetID, token string, lat, lng *float64) (*dto.ScanResponse, error) {
+	asset, err := s.AssetRepo.FindByID(assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if err := verifyAssetQRToken(asset, token); err != nil {
+		return nil, err
+	}
+
+	desks, err := s.EnumRepo.FindSecurityDesks()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &dto.ScanResponse{
+		AssetID:       asset.ID,
+		CategoryName:  asset.Category.Name,
+		LostMode:      asset.LostMode,
+		SecurityDesks: []dto.SecurityDeskResponse{},
+	}
+	for _, desk := range desks {
+		deskRes := dto.SecurityDeskResponse{
+			ID:          desk.ID,
+			Name:        desk.Name,
+			Description: desk.Description,
+			Latitude:    desk.Latitude,
+			Longitude:   desk.Longitude,
+		}
+		if lat != nil && lng != nil {
+			d := matching.Distance(*lat, *lng, desk.Latitude, desk.Longitude)
+			deskRes.DistanceM = &d
+		}
+		res.SecurityDesks = append(res.SecurityDesks, deskRes)
+	}
+	if lat != nil && lng != nil && len(res.SecurityDesks) > 0 {
+		sort.SliceStable(res.SecurityDesks, func(i, j int) bool {
+			return *res.SecurityDesks[i].DistanceM < *res.SecurityDesks[j].DistanceM
+		})
+		nearest := res.SecurityDesks[0]
+		res.NearestDesk = &nearest
+	}
+
+	what := "This " + strings.ToLower(asset.Category.Name) + " item"
+	if asset.Category.Name == "" {
+		what = "This item"
+	}
+	if asset.LostMode {
+		res.Message = what + " has been reported lost by its owner. Please tell them where you found it below, or hand it to a security desk."
+	} else {
+		res.Message = what + " belongs to a registered owner. If you found it, tell them where below, or hand it to a security desk."
+	}
+	if res.NearestDesk != nil {
+		res.Message += fmt.Sprintf(" The nearest one is %s, about %d m away.", res.NearestDesk.Name, int(*res.NearestDesk.DistanceM))
+	}
+
+	res.Challenge, err = newScanChallenge(asset.ID, asset.QRVersion)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReportFound records a found report from the scan page once the challenge from GetScan is
+// answered. finderID is set when the finder happens to be signed in.
+func (s *ScanService) ReportFound(assetID string, req dto.ScanReportFoundRequest, finderID *uuid.UUID, clientIP string) error {
+	asset, err := s.AssetRepo.FindByID(assetID)
+	if err != nil {
+		return errors.New("asset not found")
+	}
+	if err := s.verifyScanChallenge(asset, req.ChallengeToken, req.ChallengeAnswer); err != nil {
+		return err
+	}
+
+	return s.Assets.ReportFound(asset.ID.String(), dto.ReportFoundRequest{
+		LocationID:    req.LocationID,
+		Note:          req.Note,
+		ContactHandle: req.ContactHandle,
+	}, finderID, clientIP)
+}
+
+// newScanChallenge asks a small sum of a two-digit and a one-digit number. The token carries
+// the QR version it was scanned with, the expiry and a nonce, signed together with the asset
+// and the answer; only the nonce is stored, once the challenge is answered.
+func newScanChallenge(assetID uuid.UUID, qrVersion int) (dto.ScanChallenge, error) {
+	a, err := rand.Int(rand.Reader, big.NewInt(90))
+	if err != nil {
+		return dto.ScanChallenge{}, err
+	}
+	b, err := rand.Int(rand.Reader, big.NewInt(9))
+	if err != nil {
+		return dto.ScanChallenge{}, err
+	}
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		return dto.ScanChallenge{}, err
+	}
+
+	x, y := a.Int64()+10, b.Int64()+1
+	expiresAt := time.Now().Add(scanChallengeTTL).Truncate(time.Second)
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	version := strconv.Itoa(qrVersion)
+	nonceHex := hex.EncodeToString(nonce)
+	signature := utils.Sign(scanChallengePayload(assetID, version, expires, nonceHex, strconv.FormatInt(x+y, 10)))
+
+	return dto.ScanChallenge{
+		Question:  fmt.Sprintf("What is %d + %d?", x, y),
+		Token:     version + "." + expires + "." + nonceHex + "." + signature,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// verifyScanChallenge checks the answer and burns the nonce first, so a challenge allows one
+// guess and, when answered right, one report. A challenge from a sticker the owner has since
+// revoked is refused like the sticker itself.
+func (s *ScanService) verifyScanChallenge(asset *models.Asset, token, answer string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 || len(parts[2]) != 16 {
+		return errors.New("invalid challenge token")
+	}
+	version, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return errors.New("invalid challenge token")
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return errors.New("invalid challenge token")
+	}
+	if version != asset.QRVersion {
+		return errors.New("this QR code has been revoked by the owner")
+	}
+	if time.Now().Unix() > expires {
+		return errors.New("challenge has expired, scan the code again")
+	}
+	fresh, err := s.AssetRepo.UseScanChallenge(parts[2], time.Unix(expires, 0))
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return errors.New("challenge has already been answered, scan the code again")
+	}
+	if !utils.VerifySignature(scanChallengePayload(asset.ID, parts[0], parts[1], parts[2], strings.TrimSpace(answer)), parts[3]) {
+		return errors.New("wrong answer to the challenge, scan the code again")
+	}
+	return nil
+}
+
+func scanChallengePayload(assetID uuid.UUID, qrVersion, expires, nonce, answer string) string {
+	return strings.Join([]string{"SCAN", assetID.String(), qrVersion, expires, nonce, answer}, "|")
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"campus-lost-and-found/internal/dto"
+	"campus-lost-and-found/internal/models"
+	"campus-lost-and-found/internal/pagination"
 	"campus-lost-and-found/internal/repository"
 	"errors"
 	"strings"
@@ -17,14 +19,20 @@ func NewUserService(userRepo *repository.UserRepository) *UserService {
 	return &UserService{UserRepo: userRepo}
 }
 
-// GetAllUsers retrieves all users from the database
-func (s *UserService) GetAllUsers() ([]dto.UserDetailResponse, error) {
-	users, err := s.UserRepo.FindAll()
+// GetAllUsers retrieves one page of users from the database
+func (s *UserService) GetAllUsers(page pagination.Params) (*dto.Page, error) {
+	users, total, err := s.UserRepo.FindAll(page)
 	if err != nil {
 		return nil, err
 	}
+	users, nextCursor := pagination.Trim(users, page, func(user models.User) (string, uuid.UUID) {
+		if page.Field == "name" {
+			return user.Name, user.ID
+		}
+		return pagination.TimeValue(user.CreatedAt), user.ID
+	})
 
-	var userResponses []dto.UserDetailResponse
+	userResponses := []dto.UserDetailResponse{}
 	for _, user := range users {
 		facultyStr := ""
 		if user.Faculty != nil {
@@ -42,11 +50,11 @@ func (s *UserService) GetAllUsers() ([]dto.UserDetailResponse, error) {
 		})
 	}
 
-	return userResponses, nil
+	return &dto.Page{Data: userResponses, NextCursor: nextCursor, Total: total}, nil
 }
 
-// GetUserByID retrieves a specific user by ID
-func (s *UserService) GetUserByID(userID string) (*dto.UserDetailResponse, error) {
+// GetUserByID retrieves a specific user by ID. Without full, phone and identity number are redacted.
+func (s *UserService) GetUserByID(userID string, full bool) (*dto.UserDetailResponse, error) {
 	id, err := uuid.Parse(userID)
 	if err != nil {
 		return nil, errors.New("invalid user ID format")
@@ -62,15 +70,18 @@ func (s *UserService) GetUserByID(userID string) (*dto.UserDetailResponse, error
 		facultyStr = *user.Faculty
 	}
 
-	return &dto.UserDetailResponse{
-		ID:             user.ID,
-		Name:           user.Name,
-		Email:          user.Email,
-		Phone:          user.Phone,
-		IdentityNumber: user.IdentityNumber,
-		Role:           string(user.Role),
-		Faculty:        facultyStr,
-	}, nil
+	res := &dto.UserDetailResponse{
+		ID:      user.ID,
+		Name:    user.Name,
+		Email:   user.Email,
+		Role:    string(user.Role),
+		Faculty: facultyStr,
+	}
+	if full {
+		res.Phone = user.Phone
+		res.IdentityNumber = user.IdentityNumber
+	}
+	return res, nil
 }
 
 // UpdateUser updates user's Name and/or Phone
@@ -124,3 +135,102 @@ func (s *UserService) UpdateUser(userID string, req dto.UpdateUserRequest) (*dto
 		Faculty:        facultyStr,
 	}, nil
 }
+
+// CreateUser creates an account with any role and records it in the role audit trail.
+// actorID is nil when the bootstrap CLI creates the account.
+func (s *UserService) CreateUser(req dto.CreateUserRequest, actorID *uuid.UUID, source string) (*dto.UserDetailResponse, error) {
+	role := models.UserRole(req.Role)
+	user, err := newUser(s.UserRepo, dto.RegisterRequest{
+		Name:           req.Name,
+		Email:          req.Email,
+		Password:       req.Password,
+		Phone:          req.Phone,
+		IdentityNumber: req.IdentityNumber,
+		Faculty:        req.Faculty,
+	}, role)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &models.RoleChange{
+		NewRole:     role,
+		ChangedByID: actorID,
+		Source:      source,
+		Reason:      req.Reason,
+	}
+	if err := s.UserRepo.CreateWithRoleChange(user, change); err != nil {
+		return nil, err
+	}
+
+	return toUserDetailResponse(user), nil
+}
+
+// ChangeRole promotes or demotes a user and records it in the role audit trail.
+// actorID is nil when the bootstrap CLI makes the change.
+func (s *UserService) ChangeRole(userID string, req dto.ChangeRoleRequest, actorID *uuid.UUID, source string) (*dto.UserDetailResponse, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID format")
+	}
+
+	user, err := s.UserRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	// Stops an admin from locking themselves out
+	if actorID != nil && *actorID == user.ID {
+		return nil, errors.New("cannot change your own role")
+	}
+
+	newRole := models.UserRole(req.Role)
+	if user.Role == newRole {
+		return nil, errors.New("user already has this role")
+	}
+
+	change := &models.RoleChange{
+		UserID:      user.ID,
+		OldRole:     user.Role,
+		NewRole:     newRole,
+		ChangedByID: actorID,
+		Source:      source,
+		Reason:      req.Reason,
+	}
+	user.Role = newRole
+	if err := s.UserRepo.UpdateRole(user, change); err != nil {
+		return nil, err
+	}
+
+	return toUserDetailResponse(user), nil
+}
+
+// GetRoleChanges returns a user's role audit trail, newest first
+func (s *UserService) GetRoleChanges(userID string) ([]models.RoleChange, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, errors.New("invalid user ID format")
+	}
+
+	if _, err := s.UserRepo.FindByID(id); err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	return s.UserRepo.FindRoleChanges(id)
+}
+
+func toUserDetailResponse(user *models.User) *dto.UserDetailResponse {
+	facultyStr := ""
+	if user.Faculty != nil {
+		facultyStr = *user.Faculty
+	}
+
+	return &dto.UserDetailResponse{
+		ID:             user.ID,
+		Name:           user.Name,
+		Email:          user.Email,
+		Phone:          user.Phone,
+		IdentityNumber: user.IdentityNumber,
+		Role:           string(user.Role),
+		Faculty:        facultyStr,
+	}
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"os"
+	"strings"
+)
+
+// EncryptedPrefix marks values produced by Encrypt, so plaintext rows can be told apart
+const EncryptedPrefix = "enc:v1:"
+
+// Encrypt seals a value with AES-256-GCM under the server encryption key (ENCRYPTION_KEY).
+// Each call uses a fresh nonce.
+func Encrypt(plaintext string) (string, error) {
+	gcm, err := newGCM()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return EncryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value from Encrypt. It fails if the key changed or the value was tampered with.
+func Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return "", errors.New("value is not encrypted")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, EncryptedPrefix))
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM()
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, EncryptedPrefix)
+}
+
+func newGCM() (cipher.AEAD, error) {
+	key := sha256.Sum256(encryptionKey())
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// CheckEncryptionKey makes sure ENCRYPTION_KEY is set and is not the JWT secret, so answers
+// are never sealed under an empty or shared key. The server refuses to start otherwise.
+func CheckEncryptionKey() error {
+	key := os.Getenv("ENCRYPTION_KEY")
+	if key == "" {
+		return errors.New("ENCRYPTION_KEY is not set")
+	}
+	if key == os.Getenv("JWT_SECRET") {
+		return errors.New("ENCRYPTION_KEY must differ from JWT_SECRET")
+	}
+	return nil
+}
+
+func encryptionKey() []byte {
+	return []byte(os.Getenv("ENCRYPTION_KEY"))
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+)
+
+// Sign returns the hex HMAC-SHA256 of data with the server signing key
+// (SIGNING_KEY, falling back to JWT_SECRET)
+func Sign(data string) string {
+	mac := hmac.New(sha256.New, signingKey())
+	mac.Write([]byte(data))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks a signature from Sign in constant time
+func VerifySignature(data, signature string) bool {
+	return hmac.Equal([]byte(Sign(data)), []byte(signature))
+}
+
+func signingKey() []byte {
+	if key := os.Getenv("SIGNING_KEY"); key != "" {
+		return []byte(key)
+	}
+	return []byte(os.Getenv("JWT_SECRET"))
+}